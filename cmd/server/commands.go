@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"aHobeychi/personal-website/internal/config"
+)
+
+// runCommand dispatches a command line subcommand instead of starting the server
+func runCommand(cfg *config.Config, name string, args []string) error {
+	switch name {
+	case "import":
+		return runImport(cfg, args)
+	case "validate":
+		return runValidate(cfg)
+	case "revisions":
+		return runRevisions(cfg, args)
+	default:
+		return fmt.Errorf("unknown command %q (available: import, validate, revisions)", name)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"aHobeychi/personal-website/internal/config"
+	"aHobeychi/personal-website/internal/repository"
+)
+
+// runImport loads the JSON catalogs and HTML files into the SQLite content database
+func runImport(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	dbPath := flags.String("db", cfg.Content.SQLitePath, "path of the SQLite database to import into")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	target, err := repository.NewSQLiteRepository(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	stats, err := target.Import(repository.NewJSONRepository(cfg))
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	fmt.Printf("Imported %d blogs, %d projects, %d work experiences, %d certifications and %d favorites into %s\n",
+		stats.Blogs, stats.Projects, stats.WorkExperiences, stats.Certifications, stats.Favorites, *dbPath)
+	return nil
+}
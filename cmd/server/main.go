@@ -1,18 +1,24 @@
 package main
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"aHobeychi/personal-website/internal/config"
 	"aHobeychi/personal-website/internal/handler"
 	"aHobeychi/personal-website/internal/parser"
 	"aHobeychi/personal-website/internal/preprocessor"
+	"aHobeychi/personal-website/internal/repometa"
+	"aHobeychi/personal-website/internal/repository"
+	"aHobeychi/personal-website/internal/util/locale"
 	"aHobeychi/personal-website/internal/util/logger"
 	"aHobeychi/personal-website/internal/util/middleware"
+	"aHobeychi/personal-website/internal/validator"
 )
 
 // returns an array of all html files under the templates folder
@@ -41,6 +47,35 @@ func GenerateTableOfContents() {
 	}
 }
 
+func GenerateOpenGraphImages() {
+	provider := parser.GetBlogProvider()
+	if err := preprocessor.GenerateAllOGImages(provider); err != nil {
+		logger.LogError("Failed to generate blog preview images: " + err.Error())
+	}
+}
+
+// Refresh interval of the repository metadata when repositories.ttl is not set
+const defaultRepositoryMetadataTTL = 6 * time.Hour
+
+// StartRepositoryMetadata fetches the code host metadata of the project links in the background
+// The last fetched values are read back from disk so they are served right away, even while offline
+func StartRepositoryMetadata(c *config.Config) {
+	store, err := repometa.OpenStore(c.Repositories.CachePath)
+	if err != nil {
+		logger.LogWarning("Ignoring the repository metadata cache: " + err.Error())
+	}
+
+	ttl := time.Duration(c.Repositories.TTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultRepositoryMetadataTTL
+	}
+	client := repometa.NewGitHubClient(c.Repositories.BaseURL, os.Getenv("GITHUB_TOKEN"), time.Duration(c.Repositories.Timeout)*time.Second)
+	enricher := repometa.NewEnricher(client, store, ttl)
+	parser.SetRepositoryMetadata(enricher)
+
+	go enricher.Run(context.Background(), ttl, parser.ProjectLinks)
+}
+
 func main() {
 	config, err := config.Load()
 	if err != nil {
@@ -52,13 +87,52 @@ func main() {
 	logger.SetLogLevel(config.Logging.Level)
 	logger.LogDebug("Environment set to: " + config.Server.Environment)
 
+	// Subcommands run against the loaded configuration instead of starting the server
+	if len(os.Args) > 1 {
+		if err := runCommand(config, os.Args[1], os.Args[2:]); err != nil {
+			logger.LogError(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Select where the content is read from
+	repo, err := repository.New(config)
+	if err != nil {
+		logger.LogError("Failed to initialize content repository: " + err.Error())
+		return
+	}
+	parser.SetRepository(repo)
+
+	if config.Repositories.Enabled {
+		StartRepositoryMetadata(config)
+	}
+	if config.Revisions.Enabled {
+		StartRevisionHistory(config)
+	}
+
 	if config.Server.Environment == "production" {
 		logger.LogDebug("Production mode enabled")
 		GenerateTableOfContents()
+		GenerateOpenGraphImages()
+
+		// Refuse to serve a catalog that references missing or malformed content
+		report := validator.Validate(config)
+		for _, issue := range report.Issues {
+			logger.LogWarning(issue.String())
+		}
+		if report.HasErrors() {
+			logger.LogError("Content validation failed, run the validate command for details")
+			os.Exit(1)
+		}
 	} else {
 		logger.LogDebug("Development mode enabled")
 	}
 
+	if !locale.Supported(config.Site.Locale) {
+		logger.LogWarning("Unsupported locale " + config.Site.Locale + ", dates will be formatted in " + locale.DefaultLocale)
+	}
+
 	htmlFiles := getHtmlFiles(config.Paths.Templates)
 	handler.InitializeTemplates(htmlFiles)
 
@@ -75,16 +149,49 @@ func main() {
 	mux.HandleFunc("/resume", handler.ServeResume)
 	mux.HandleFunc("/project", handler.ServeProjectsList)
 	mux.HandleFunc("/blog", handler.ServeBlogList)
+	mux.HandleFunc("/favorites", handler.ServeFavorites)
+	mux.HandleFunc("/skills", handler.ServeSkills)
+	mux.HandleFunc("/timeline", handler.ServeTimeline)
+
+	// JSON and Markdown representations of the pages, also reachable through the Accept header
+	for _, suffix := range []string{".json", ".md"} {
+		mux.HandleFunc("/resume"+suffix, handler.ServeResume)
+		mux.HandleFunc("/project"+suffix, handler.ServeProjectsList)
+		mux.HandleFunc("/blog"+suffix, handler.ServeBlogList)
+		mux.HandleFunc("/skills"+suffix, handler.ServeSkills)
+	}
+	// The resume is also exported as plain text and PDF
+	for _, suffix := range []string{".txt", ".pdf"} {
+		mux.HandleFunc("/resume"+suffix, handler.ServeResume)
+	}
+	// Resumes tailored by the profiles of the configuration, with the same export suffixes
+	mux.HandleFunc("/resume/{profile}", handler.ServeResumeProfile)
+	// Project detail pages, with the same ".json" and ".md" suffixes
+	mux.HandleFunc("/project/{slug}", handler.ServeProjectContent)
+
 	mux.HandleFunc("/blog/", func(w http.ResponseWriter, r *http.Request) {
 		// Check if the request is for the table of contents
 		if strings.Contains(r.URL.Path, "/table-of-contents") {
 			handler.ServeBlogTableOfContents(w, r)
 			return
 		}
+		// Check if the request is for the preview image
+		if strings.HasSuffix(r.URL.Path, "/og.png") {
+			handler.ServeBlogOGImage(w, r)
+			return
+		}
+		// Check if the request is for the archive by year and month
+		if handler.IsBlogArchivePath(r.URL.Path) {
+			handler.ServeBlogArchive(w, r)
+			return
+		}
 		// Otherwise, serve the regular blog content
 		handler.ServeBlogContent(w, r)
 	})
 
+	// Read-only JSON API
+	mux.Handle(handler.APIPrefix+"/", middleware.CORSMiddleware(handler.NewAPIHandler(), config.API.CORS.AllowedOrigins, config.API.CORS.MaxAge))
+
 	// Apply middleware chain
 	var handler http.Handler = mux
 
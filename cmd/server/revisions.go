@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/githistory"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+)
+
+// Upper bound of the time spent reading the git history
+const revisionHistoryTimeout = 30 * time.Second
+
+// loadRevisionHistory reads the history of the blog and project Markdown sources and their catalogs from git
+func loadRevisionHistory(c *config.Config) (*githistory.History, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), revisionHistoryTimeout)
+	defer cancel()
+
+	files := []string{c.Paths.BlogMarkdown, c.Paths.ProjectMarkdown}
+	catalogs := []githistory.Catalog{
+		{Path: c.Paths.BlogsJSON, Key: func(entry json.RawMessage) (string, bool) {
+			var blog struct {
+				Id string `json:"id"`
+			}
+			err := json.Unmarshal(entry, &blog)
+			return blog.Id, err == nil && blog.Id != ""
+		}},
+		{Path: c.Paths.ProjectsJSON, Key: func(entry json.RawMessage) (string, bool) {
+			// Only the fields of the slug, so older versions with other date formats still decode
+			var project struct {
+				Name string `json:"name"`
+				Slug string `json:"slug"`
+			}
+			err := json.Unmarshal(entry, &project)
+			id := models.Project{Name: project.Name, Slug: project.Slug}.ID()
+			return id, err == nil && id != ""
+		}},
+	}
+	return githistory.Load(ctx, config.ProjectRoot(), files, catalogs)
+}
+
+// StartRevisionHistory reads the revisions of the content from git,
+// falling back to the history saved by the revisions command where the repository is not available
+func StartRevisionHistory(c *config.Config) {
+	history, err := loadRevisionHistory(c)
+	if err != nil {
+		logger.LogDebug("Reading the revision history from git failed, using " + c.Revisions.CachePath + ": " + err.Error())
+		if history, err = githistory.Open(c.Revisions.CachePath, config.ProjectRoot()); err != nil {
+			logger.LogWarning("Content is served without revision history: " + err.Error())
+			return
+		}
+	}
+	parser.SetRevisionHistory(history)
+}
+
+// runRevisions saves the revision history read from git, for deployments shipped without the repository
+func runRevisions(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("revisions", flag.ContinueOnError)
+	output := flags.String("o", cfg.Revisions.CachePath, "path of the revision history file to write")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *output == "" {
+		return fmt.Errorf("no output path, set revisions.cachePath or pass -o")
+	}
+
+	history, err := loadRevisionHistory(cfg)
+	if err != nil {
+		return fmt.Errorf("reading the revision history failed: %w", err)
+	}
+	if err := history.Save(*output); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved the revisions of %d files and %d catalogs to %s\n", len(history.Files), len(history.Entries), *output)
+	return nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"aHobeychi/personal-website/internal/config"
+	"aHobeychi/personal-website/internal/validator"
+)
+
+// runValidate checks every catalog and prints each inconsistency found
+func runValidate(cfg *config.Config) error {
+	report := validator.Validate(cfg)
+
+	for _, issue := range report.Issues {
+		fmt.Fprintln(os.Stderr, issue.String())
+	}
+
+	if report.HasErrors() {
+		return errors.New("content validation failed")
+	}
+
+	fmt.Printf("Content is valid (%d warnings)\n", len(report.Issues))
+	return nil
+}
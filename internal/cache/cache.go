@@ -22,6 +22,8 @@ type Cache[T any] struct {
 	disableFlag bool
 	ttl         time.Duration
 	name        string
+	decode      func(data []byte) ([]T, error)
+	onLoad      func(data []T)
 }
 
 // NewCache creates a new cache with the specified parameters
@@ -45,6 +47,17 @@ func NewCache[T any](path string, ttl time.Duration, name string) *Cache[T] {
 	return c
 }
 
+// SetDecoder replaces the JSON decoding of the file, for catalogs whose entries do not map one to one to T
+func (c *Cache[T]) SetDecoder(decode func(data []byte) ([]T, error)) {
+	c.decode = decode
+}
+
+// SetOnLoad registers a function called with the data each time the cache is populated from the file
+// It runs outside the cache lock, so it may read from the cache itself, e.g. to precompute derived data
+func (c *Cache[T]) SetOnLoad(onLoad func(data []T)) {
+	c.onLoad = onLoad
+}
+
 // Clear removes all cached data and resets the cache state
 func (c *Cache[T]) Clear() {
 	c.mutex.Lock()
@@ -74,23 +87,18 @@ func (c *Cache[T]) Get(limit ...int) ([]T, error) {
 	}
 
 	// Initialize cache if not already done
+	loaded := false
 	c.once.Do(func() {
 		c.mutex.Lock()
 		defer c.mutex.Unlock()
 		logger.DebugLogger.Printf("%s cache enabled, reading from file", c.name)
 
-		file, err := os.Open(c.path)
-		if err != nil {
-			c.err = err
-			return
-		}
-		defer file.Close()
-
-		err = json.NewDecoder(file).Decode(&c.data)
-		if err != nil {
-			c.err = err
-		}
+		c.data, c.err = c.read()
+		loaded = c.err == nil
 	})
+	if loaded && c.onLoad != nil {
+		c.onLoad(c.data)
+	}
 
 	logger.DebugLogger.Printf("%s cache already populated, returning data", c.name)
 
@@ -108,16 +116,9 @@ func (c *Cache[T]) Get(limit ...int) ([]T, error) {
 
 // loadFromFile reads the JSON file and decodes it into the specified type
 func (c *Cache[T]) loadFromFile(limit ...int) ([]T, error) {
-	file, err := os.Open(c.path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open %s: %w", c.name, err)
-	}
-	defer file.Close()
-
-	var data []T
-	err = json.NewDecoder(file).Decode(&data)
+	data, err := c.read()
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode %s JSON: %w", c.name, err)
+		return nil, err
 	}
 
 	// If a limit is provided, return only that number of items
@@ -127,3 +128,22 @@ func (c *Cache[T]) loadFromFile(limit ...int) ([]T, error) {
 
 	return data, nil
 }
+
+// read decodes the JSON file, with the decoder set by SetDecoder if any
+func (c *Cache[T]) read() ([]T, error) {
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", c.name, err)
+	}
+
+	var data []T
+	if c.decode != nil {
+		data, err = c.decode(content)
+	} else {
+		err = json.Unmarshal(content, &data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s JSON: %w", c.name, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,144 @@
+package cache
+
+import "slices"
+
+// Query builds a filtered, sorted and paginated view over a list of items
+// The source is only read when Run is called, so a query can be built once and reused
+type Query[T any] struct {
+	source     func() ([]T, error)
+	predicates []func(T) bool
+	compare    func(a, b T) int
+	offset     int
+	limit      int
+}
+
+// NewQuery creates a query over the items returned by source
+func NewQuery[T any](source func() ([]T, error)) *Query[T] {
+	return &Query[T]{source: source}
+}
+
+// Where keeps only the items matching the predicate
+// Calling Where several times keeps the items matching every predicate
+func (q *Query[T]) Where(predicate func(T) bool) *Query[T] {
+	q.predicates = append(q.predicates, predicate)
+	return q
+}
+
+// SortBy orders the matching items with a comparator, keeping the source order for ties
+func (q *Query[T]) SortBy(compare func(a, b T) int) *Query[T] {
+	q.compare = compare
+	return q
+}
+
+// Offset skips the first n matching items
+func (q *Query[T]) Offset(n int) *Query[T] {
+	q.offset = max(n, 0)
+	return q
+}
+
+// Limit returns at most n items, a limit of zero returns every item
+func (q *Query[T]) Limit(n int) *Query[T] {
+	q.limit = max(n, 0)
+	return q
+}
+
+// Page sets the offset and limit for a 1-based page of the given size
+func (q *Query[T]) Page(page int, size int) *Query[T] {
+	page = max(page, 1)
+	return q.Offset((page - 1) * size).Limit(size)
+}
+
+// Run executes the query against the source
+// The returned items never alias the source slice, so callers are free to modify them
+func (q *Query[T]) Run() (Result[T], error) {
+	items, err := q.source()
+	if err != nil {
+		return Result[T]{}, err
+	}
+
+	matching := make([]T, 0, len(items))
+	for _, item := range items {
+		if q.matches(item) {
+			matching = append(matching, item)
+		}
+	}
+
+	if q.compare != nil {
+		slices.SortStableFunc(matching, q.compare)
+	}
+
+	result := Result[T]{
+		Total:  len(matching),
+		Offset: q.offset,
+		Limit:  q.limit,
+	}
+
+	start := min(q.offset, len(matching))
+	end := len(matching)
+	if q.limit > 0 {
+		end = min(start+q.limit, end)
+	}
+	result.Items = matching[start:end]
+
+	return result, nil
+}
+
+// matches reports whether an item satisfies every predicate
+func (q *Query[T]) matches(item T) bool {
+	for _, predicate := range q.predicates {
+		if !predicate(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Result is a page of items returned by a query along with pagination metadata
+type Result[T any] struct {
+	Items  []T
+	Total  int // number of items matching the query before offset and limit
+	Offset int
+	Limit  int
+}
+
+// Page returns the 1-based page number of the result
+func (r Result[T]) Page() int {
+	if r.Limit == 0 {
+		return 1
+	}
+	return r.Offset/r.Limit + 1
+}
+
+// PageCount returns the number of pages needed to show every matching item
+func (r Result[T]) PageCount() int {
+	if r.Limit == 0 || r.Total == 0 {
+		return 1
+	}
+	return (r.Total + r.Limit - 1) / r.Limit
+}
+
+// IsPastEnd reports whether the page starts after the last matching item
+// The first page is never past the end, even when nothing matches
+func (r Result[T]) IsPastEnd() bool {
+	return r.Page() > r.PageCount()
+}
+
+// HasNext reports whether more items follow this page
+func (r Result[T]) HasNext() bool {
+	return r.Offset+len(r.Items) < r.Total
+}
+
+// HasPrevious reports whether items precede this page
+func (r Result[T]) HasPrevious() bool {
+	return r.Offset > 0
+}
+
+// NextPage returns the page number following this one
+func (r Result[T]) NextPage() int {
+	return r.Page() + 1
+}
+
+// PreviousPage returns the page number preceding this one
+func (r Result[T]) PreviousPage() int {
+	return max(r.Page()-1, 1)
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func numbers() ([]int, error) {
+	return []int{5, 3, 8, 1, 9, 2, 7}, nil
+}
+
+func TestQueryRun(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         *Query[int]
+		expectedItems []int
+		expectedTotal int
+		expectedPage  int
+		expectedNext  bool
+		pastEnd       bool
+	}{
+		{
+			name:          "No options returns everything in source order",
+			query:         NewQuery(numbers),
+			expectedItems: []int{5, 3, 8, 1, 9, 2, 7},
+			expectedTotal: 7,
+			expectedPage:  1,
+			expectedNext:  false,
+		},
+		{
+			name:          "Filter and sort",
+			query:         NewQuery(numbers).Where(func(n int) bool { return n > 4 }).SortBy(cmp.Compare[int]),
+			expectedItems: []int{5, 7, 8, 9},
+			expectedTotal: 4,
+			expectedPage:  1,
+			expectedNext:  false,
+		},
+		{
+			name:          "Second page",
+			query:         NewQuery(numbers).SortBy(cmp.Compare[int]).Page(2, 3),
+			expectedItems: []int{5, 7, 8},
+			expectedTotal: 7,
+			expectedPage:  2,
+			expectedNext:  true,
+		},
+		{
+			name:          "Offset past the end",
+			query:         NewQuery(numbers).Offset(20).Limit(5),
+			expectedItems: []int{},
+			expectedTotal: 7,
+			expectedPage:  5,
+			expectedNext:  false,
+			pastEnd:       true,
+		},
+		{
+			name:          "Nothing matching on the first page",
+			query:         NewQuery(numbers).Where(func(n int) bool { return n > 100 }).Page(1, 3),
+			expectedItems: []int{},
+			expectedTotal: 0,
+			expectedPage:  1,
+			expectedNext:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.query.Run()
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if !reflect.DeepEqual(result.Items, tt.expectedItems) {
+				t.Errorf("Run().Items = %v, want %v", result.Items, tt.expectedItems)
+			}
+			if result.Total != tt.expectedTotal {
+				t.Errorf("Run().Total = %d, want %d", result.Total, tt.expectedTotal)
+			}
+			if result.Page() != tt.expectedPage {
+				t.Errorf("Run().Page() = %d, want %d", result.Page(), tt.expectedPage)
+			}
+			if result.HasNext() != tt.expectedNext {
+				t.Errorf("Run().HasNext() = %v, want %v", result.HasNext(), tt.expectedNext)
+			}
+			if result.IsPastEnd() != tt.pastEnd {
+				t.Errorf("Run().IsPastEnd() = %v, want %v", result.IsPastEnd(), tt.pastEnd)
+			}
+		})
+	}
+}
+
+func TestQueryDoesNotModifySource(t *testing.T) {
+	source := []int{3, 1, 2}
+	_, err := NewQuery(func() ([]int, error) { return source, nil }).SortBy(cmp.Compare[int]).Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !reflect.DeepEqual(source, []int{3, 1, 2}) {
+		t.Errorf("source = %v, want it unchanged", source)
+	}
+}
+
+func TestQuerySourceError(t *testing.T) {
+	expected := errors.New("boom")
+	_, err := NewQuery(func() ([]int, error) { return nil, expected }).Run()
+	if !errors.Is(err, expected) {
+		t.Errorf("Run() error = %v, want %v", err, expected)
+	}
+}
@@ -20,11 +20,80 @@ type Config struct {
 		AssetFiles         string `json:"assetFiles"`
 		BlogHTML           string `json:"blogHTML"`
 		TocHTML            string `json:"tocHTML"`
+		BlogMarkdown       string `json:"blogMarkdown"`
+		ProjectMarkdown    string `json:"projectMarkdown"`
+		ProjectHTML        string `json:"projectHTML"`
 		ProjectsJSON       string `json:"projectsJSON"`
 		BlogsJSON          string `json:"blogsJSON"`
 		WorkExperienceJSON string `json:"workExperienceJSON"`
 		CertificationsJSON string `json:"certificationsJSON"`
+		FavoritesJSON      string `json:"favoritesJSON"`
+		OGImages           string `json:"ogImages"`
 	} `json:"paths"`
+	Site struct {
+		// Title names the site in page titles, link previews and preview images
+		Title    string `json:"title"`
+		Locale   string `json:"locale"`
+		PageSize int    `json:"pageSize"`
+		Author   struct {
+			Name     string `json:"name"`
+			Email    string `json:"email"`
+			Summary  string `json:"summary"`
+			Location struct {
+				City        string `json:"city"`
+				Region      string `json:"region"`
+				CountryCode string `json:"countryCode"`
+			} `json:"location"`
+			SameAs []string `json:"sameAs"`
+		} `json:"author"`
+		OGImage struct {
+			Background string `json:"background"`
+			Foreground string `json:"foreground"`
+			Accent     string `json:"accent"`
+		} `json:"ogImage"`
+	} `json:"site"`
+	Resume struct {
+		// Profiles are tailored views of the resume served at /resume/{name}
+		Profiles       map[string]ResumeProfile `json:"profiles"`
+		Certifications struct {
+			// Order lists certifications by "date" (default) or grouped by "issuer"
+			Order string `json:"order"`
+			// ExpiringSoonDays is how long before expiry a certification is flagged, 90 when unset
+			ExpiringSoonDays int `json:"expiringSoonDays"`
+		} `json:"certifications"`
+	} `json:"resume"`
+	Skills struct {
+		// Aliases rename tags, e.g. "golang" to "Go", ignoring case
+		Aliases    map[string]string `json:"aliases"`
+		Categories []SkillCategory   `json:"categories"`
+	} `json:"skills"`
+	Content struct {
+		Backend    string `json:"backend"`
+		SQLitePath string `json:"sqlitePath"`
+	} `json:"content"`
+	Repositories struct {
+		// Enabled starts the background job fetching the code host metadata of the project links
+		Enabled bool `json:"enabled"`
+		// BaseURL is the GitHub API to query, https://api.github.com when unset
+		BaseURL   string `json:"baseURL"`
+		CachePath string `json:"cachePath"`
+		// TTL is how long fetched metadata is kept before being refetched, in seconds
+		TTL int `json:"ttl"`
+		// Timeout bounds each request to the code host, in seconds
+		Timeout int `json:"timeout"`
+	} `json:"repositories"`
+	Revisions struct {
+		// Enabled reads the revision history of the content from git when the server starts
+		Enabled bool `json:"enabled"`
+		// CachePath is the history saved by the revisions command, read when git or the .git directory is missing
+		CachePath string `json:"cachePath"`
+	} `json:"revisions"`
+	API struct {
+		CORS struct {
+			AllowedOrigins []string `json:"allowedOrigins"`
+			MaxAge         int      `json:"maxAge"`
+		} `json:"cors"`
+	} `json:"api"`
 	Features struct {
 		CacheEnabled bool `json:"cacheEnabled"`
 		CacheTTL     int  `json:"cacheTTL"`
@@ -35,6 +104,27 @@ type Config struct {
 	}
 }
 
+// SkillCategory groups skills on the skills page and the resume, listed in configuration order
+type SkillCategory struct {
+	Name   string   `json:"name"`
+	Skills []string `json:"skills"`
+}
+
+// ResumeProfile selects and orders the resume entries relevant to a focus area
+// An entry matches a tag when one of its tags, or the name or issuer of a certification, contains the tag as whole words
+type ResumeProfile struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	// Unmatched is what happens to the entries matching none of the tags: "hide" (default) or "shorten"
+	Unmatched string `json:"unmatched"`
+	// SortByRelevance orders work experience and certifications by matching tags instead of by date
+	// Projects are always ordered by relevance
+	SortByRelevance bool `json:"sortByRelevance"`
+	// MaxProjects limits the projects listed, 0 lists every matching project
+	MaxProjects int `json:"maxProjects"`
+}
+
 // global config instance
 var cfg *Config
 
@@ -91,10 +181,25 @@ func (c *Config) normalizePaths() {
 	c.Paths.AssetFiles = makeAbsolute(c.Paths.AssetFiles, projectRoot)
 	c.Paths.BlogHTML = makeAbsolute(c.Paths.BlogHTML, projectRoot)
 	c.Paths.TocHTML = makeAbsolute(c.Paths.TocHTML, projectRoot)
+	c.Paths.BlogMarkdown = makeAbsolute(c.Paths.BlogMarkdown, projectRoot)
+	c.Paths.ProjectMarkdown = makeAbsolute(c.Paths.ProjectMarkdown, projectRoot)
+	c.Paths.ProjectHTML = makeAbsolute(c.Paths.ProjectHTML, projectRoot)
 	c.Paths.BlogsJSON = makeAbsolute(c.Paths.BlogsJSON, projectRoot)
 	c.Paths.WorkExperienceJSON = makeAbsolute(c.Paths.WorkExperienceJSON, projectRoot)
 	c.Paths.CertificationsJSON = makeAbsolute(c.Paths.CertificationsJSON, projectRoot)
 	c.Paths.ProjectsJSON = makeAbsolute(c.Paths.ProjectsJSON, projectRoot)
+	c.Paths.FavoritesJSON = makeAbsolute(c.Paths.FavoritesJSON, projectRoot)
+	c.Paths.OGImages = makeAbsolute(c.Paths.OGImages, projectRoot)
+
+	if c.Content.SQLitePath != "" {
+		c.Content.SQLitePath = makeAbsolute(c.Content.SQLitePath, projectRoot)
+	}
+	if c.Repositories.CachePath != "" {
+		c.Repositories.CachePath = makeAbsolute(c.Repositories.CachePath, projectRoot)
+	}
+	if c.Revisions.CachePath != "" {
+		c.Revisions.CachePath = makeAbsolute(c.Revisions.CachePath, projectRoot)
+	}
 }
 
 // makeAbsolute converts a path to absolute if it's not already
@@ -105,6 +210,11 @@ func makeAbsolute(path string, basePath string) string {
 	return filepath.Join(basePath, path)
 }
 
+// ProjectRoot returns the directory the relative paths of the configuration are resolved against
+func ProjectRoot() string {
+	return getProjectRoot()
+}
+
 // getProjectRoot attempts to determine the project root directory
 func getProjectRoot() string {
 	// Use working directory
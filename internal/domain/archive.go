@@ -0,0 +1,153 @@
+package models
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ArchiveSegment names the archive index in /blog/archive
+const ArchiveSegment = "archive"
+
+// IsArchiveSegment reports whether the first segment of a /blog/ path is the archive index or a year
+// Blogs with such an id would be shadowed by the archive pages
+func IsArchiveSegment(segment string) bool {
+	if segment == ArchiveSegment {
+		return true
+	}
+	_, ok := parseArchiveYear(segment)
+	return ok
+}
+
+// ParseArchivePeriod reads the year and optional month of the segments of /blog/{year}/{month}
+// month is 0 for a whole year
+func ParseArchivePeriod(segments []string) (year int, month time.Month, ok bool) {
+	if len(segments) == 0 || len(segments) > 2 {
+		return 0, 0, false
+	}
+	if year, ok = parseArchiveYear(segments[0]); !ok {
+		return 0, 0, false
+	}
+	if len(segments) == 1 {
+		return year, 0, true
+	}
+
+	if len(segments[1]) > 2 || !isDigits(segments[1]) {
+		return 0, 0, false
+	}
+	m, _ := strconv.Atoi(segments[1])
+	if m < 1 || m > 12 {
+		return 0, 0, false
+	}
+	return year, time.Month(m), true
+}
+
+// parseArchiveYear reads a four digit year
+func parseArchiveYear(segment string) (int, bool) {
+	if len(segment) != 4 || !isDigits(segment) {
+		return 0, false
+	}
+	year, _ := strconv.Atoi(segment)
+	return year, true
+}
+
+// isDigits reports whether text is made of ASCII digits only
+func isDigits(text string) bool {
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return text != ""
+}
+
+// ArchiveMonth holds the blogs published in a month, newest first
+type ArchiveMonth struct {
+	Year  int        `json:"year"`
+	Month time.Month `json:"month"`
+	Blogs []Blog     `json:"blogs"`
+}
+
+// Date returns the month as a month precision date, for display
+func (m ArchiveMonth) Date() Date {
+	return Date{Time: time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.UTC), MonthOnly: true}
+}
+
+// Count returns the number of blogs published in the month
+func (m ArchiveMonth) Count() int {
+	return len(m.Blogs)
+}
+
+// ArchiveYear holds the months of a year with published blogs, newest first
+type ArchiveYear struct {
+	Year   int            `json:"year"`
+	Months []ArchiveMonth `json:"months"`
+}
+
+// Count returns the number of blogs published in the year
+func (y ArchiveYear) Count() int {
+	count := 0
+	for _, month := range y.Months {
+		count += month.Count()
+	}
+	return count
+}
+
+// Blogs returns the blogs published in the year, newest first
+func (y ArchiveYear) Blogs() []Blog {
+	var blogs []Blog
+	for _, month := range y.Months {
+		blogs = append(blogs, month.Blogs...)
+	}
+	return blogs
+}
+
+// BuildBlogArchive groups blogs by year and month of publication, newest first
+// Blogs without a published date are left out
+func BuildBlogArchive(blogs []Blog) []ArchiveYear {
+	sorted := slices.Clone(blogs)
+	slices.SortStableFunc(sorted, CompareBlogs)
+
+	var years []ArchiveYear
+	for _, blog := range sorted {
+		if blog.PublishedDate.IsOpen() {
+			continue
+		}
+		year, month := blog.PublishedDate.Year(), blog.PublishedDate.Month()
+
+		if len(years) == 0 || years[len(years)-1].Year != year {
+			years = append(years, ArchiveYear{Year: year})
+		}
+		current := &years[len(years)-1]
+		if len(current.Months) == 0 || current.Months[len(current.Months)-1].Month != month {
+			current.Months = append(current.Months, ArchiveMonth{Year: year, Month: month})
+		}
+		last := &current.Months[len(current.Months)-1]
+		last.Blogs = append(last.Blogs, blog)
+	}
+	return years
+}
+
+// FindArchiveYear returns the archive of a year
+func FindArchiveYear(archive []ArchiveYear, year int) (ArchiveYear, bool) {
+	for _, y := range archive {
+		if y.Year == year {
+			return y, true
+		}
+	}
+	return ArchiveYear{}, false
+}
+
+// FindArchiveMonth returns the archive of a month
+func FindArchiveMonth(archive []ArchiveYear, year int, month time.Month) (ArchiveMonth, bool) {
+	y, ok := FindArchiveYear(archive, year)
+	if !ok {
+		return ArchiveMonth{}, false
+	}
+	for _, m := range y.Months {
+		if m.Month == month {
+			return m, true
+		}
+	}
+	return ArchiveMonth{}, false
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildBlogArchive(t *testing.T) {
+	blogs := []Blog{
+		{Id: "april", PublishedDate: MustParseDate("2025-04-20")},
+		{Id: "may", PublishedDate: MustParseDate("2025-05-02")},
+		{Id: "late-april", PublishedDate: MustParseDate("2025-04-28")},
+		{Id: "old", PublishedDate: MustParseDate("2023-11")},
+		{Id: "draft"},
+	}
+
+	archive := BuildBlogArchive(blogs)
+	if len(archive) != 2 || archive[0].Year != 2025 || archive[0].Count() != 3 || archive[1].Year != 2023 {
+		t.Fatalf("BuildBlogArchive() = %+v, want 2025 with 3 notes then 2023", archive)
+	}
+
+	april, ok := FindArchiveMonth(archive, 2025, time.April)
+	if !ok || april.Count() != 2 || april.Blogs[0].Id != "late-april" {
+		t.Errorf("FindArchiveMonth(April 2025) = %+v, %v, want both April notes newest first", april, ok)
+	}
+	if _, ok := FindArchiveMonth(archive, 2025, time.June); ok {
+		t.Error("FindArchiveMonth(June 2025) found a month without notes")
+	}
+}
+
+func TestParseArchivePeriod(t *testing.T) {
+	tests := []struct {
+		segments []string
+		year     int
+		month    time.Month
+		ok       bool
+	}{
+		{[]string{"2025"}, 2025, 0, true},
+		{[]string{"2025", "04"}, 2025, time.April, true},
+		{[]string{"2025", "4"}, 2025, time.April, true},
+		{[]string{"2025", "13"}, 0, 0, false},
+		{[]string{"+202"}, 0, 0, false},
+		{[]string{"Personal-Website"}, 0, 0, false},
+		{[]string{"2025", "04", "01"}, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		year, month, ok := ParseArchivePeriod(tt.segments)
+		if year != tt.year || month != tt.month || ok != tt.ok {
+			t.Errorf("ParseArchivePeriod(%v) = %d, %d, %v, want %d, %d, %v", tt.segments, year, month, ok, tt.year, tt.month, tt.ok)
+		}
+	}
+}
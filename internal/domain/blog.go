@@ -1,10 +1,52 @@
 package models
 
+import "strings"
+
+// Reading speed used to estimate the reading time of a post
+const WordsPerMinute = 200
+
 type Blog struct {
 	Id            string   `json:"id"`
 	Title         string   `json:"title"`
 	Description   string   `json:"description"`
 	Tags          []string `json:"tags"`
-	PublishedDate string   `json:"publishedDate"`
+	PublishedDate Date     `json:"publishedDate"`
+	UpdatedDate   Date     `json:"updatedDate"` // open when the post was never revised
 	ExternalLink  string   `json:"externalLink"`
+	WordCount     int      `json:"wordCount,omitempty"` // counted from the body when served, not stored in the catalog
+}
+
+// ReadingMinutes estimates the minutes needed to read the post, 0 when its words are not counted
+func (b Blog) ReadingMinutes() int {
+	return (b.WordCount + WordsPerMinute - 1) / WordsPerMinute
+}
+
+// WasUpdated reports whether the post was revised after it was published
+func (b Blog) WasUpdated() bool {
+	return !b.UpdatedDate.IsOpen() && b.UpdatedDate.After(b.PublishedDate.Time)
+}
+
+// CompareBlogs orders blogs from the most recently published to the oldest
+func CompareBlogs(a Blog, b Blog) int {
+	return CompareNewestFirst(a.PublishedDate, b.PublishedDate)
+}
+
+// HasTag reports whether the blog is tagged with tag, ignoring case
+func (b Blog) HasTag(tag string) bool {
+	return hasTag(b.Tags, tag)
+}
+
+// Matches reports whether the title, description or tags contain the search text
+func (b Blog) Matches(text string) bool {
+	return containsText(text, b.Title, b.Description, strings.Join(b.Tags, " "))
+}
+
+// CompareBlogsOldestFirst orders blogs from the oldest to the most recently published
+func CompareBlogsOldestFirst(a Blog, b Blog) int {
+	return CompareBlogs(b, a)
+}
+
+// CompareBlogsByTitle orders blogs alphabetically by title
+func CompareBlogsByTitle(a Blog, b Blog) int {
+	return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
 }
@@ -0,0 +1,232 @@
+package models
+
+import (
+	"html"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Words too common to tell posts apart, left out of the body terms
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true, "any": true,
+	"are": true, "as": true, "at": true, "be": true, "because": true, "been": true, "but": true, "by": true,
+	"can": true, "could": true, "do": true, "does": true, "each": true, "for": true, "from": true, "get": true,
+	"had": true, "has": true, "have": true, "how": true, "i": true, "if": true, "in": true, "into": true,
+	"is": true, "it": true, "its": true, "just": true, "like": true, "make": true, "more": true, "most": true,
+	"my": true, "no": true, "not": true, "of": true, "on": true, "one": true, "only": true, "or": true,
+	"other": true, "our": true, "out": true, "so": true, "some": true, "such": true, "than": true, "that": true,
+	"the": true, "their": true, "them": true, "then": true, "there": true, "these": true, "they": true,
+	"this": true, "those": true, "to": true, "up": true, "use": true, "used": true, "using": true, "was": true,
+	"we": true, "were": true, "what": true, "when": true, "which": true, "while": true, "who": true,
+	"will": true, "with": true, "would": true, "you": true, "your": true,
+}
+
+// BlogIndex holds the neighbours and related posts of every blog, computed once for a list of blogs
+type BlogIndex struct {
+	ordered  []Blog // dated blogs, newest first
+	position map[string]int
+	related  map[string][]Blog
+	words    map[string]int
+}
+
+// NewBlogIndex orders blogs by publish date and ranks up to limit related posts for each of them
+// Related posts are scored by the overlap of their tags and the terms shared by their bodies,
+// bodies maps blog ids to their HTML and may miss some blogs
+func NewBlogIndex(blogs []Blog, bodies map[string]string, limit int) *BlogIndex {
+	index := &BlogIndex{position: map[string]int{}, related: map[string][]Blog{}, words: map[string]int{}}
+	for id, body := range bodies {
+		index.words[id] = CountWords(body)
+	}
+
+	sorted := slices.Clone(blogs)
+	slices.SortStableFunc(sorted, CompareBlogs)
+	for _, blog := range sorted {
+		if blog.PublishedDate.IsOpen() {
+			continue
+		}
+		index.position[blog.Id] = len(index.ordered)
+		index.ordered = append(index.ordered, blog)
+	}
+
+	vectors := termVectors(sorted, bodies)
+	for i, blog := range sorted {
+		type match struct {
+			blog  Blog
+			score float64
+		}
+		var matches []match
+		for j, other := range sorted {
+			if i == j {
+				continue
+			}
+			score := tagOverlap(blog.Tags, other.Tags) + cosineSimilarity(vectors[i], vectors[j])
+			if score > 0 {
+				matches = append(matches, match{other, score})
+			}
+		}
+		// Sorted newest first already, so the stable sort keeps newer posts ahead on equal scores
+		slices.SortStableFunc(matches, func(a, b match) int {
+			switch {
+			case a.score > b.score:
+				return -1
+			case a.score < b.score:
+				return 1
+			}
+			return 0
+		})
+
+		for _, m := range matches[:min(limit, len(matches))] {
+			index.related[blog.Id] = append(index.related[blog.Id], m.blog)
+		}
+	}
+	return index
+}
+
+// Previous returns the post published just before a blog
+func (x *BlogIndex) Previous(id string) (Blog, bool) {
+	i, ok := x.position[id]
+	if !ok || i+1 >= len(x.ordered) {
+		return Blog{}, false
+	}
+	return x.ordered[i+1], true
+}
+
+// Next returns the post published just after a blog
+func (x *BlogIndex) Next(id string) (Blog, bool) {
+	i, ok := x.position[id]
+	if !ok || i == 0 {
+		return Blog{}, false
+	}
+	return x.ordered[i-1], true
+}
+
+// Related returns the posts most related to a blog, best match first
+func (x *BlogIndex) Related(id string) []Blog {
+	return x.related[id]
+}
+
+// WordCount returns the number of words in the body of a blog, 0 when its body is unknown
+func (x *BlogIndex) WordCount(id string) int {
+	return x.words[id]
+}
+
+// tagOverlap returns the share of the tags of two posts they have in common, from 0 to 1
+func tagOverlap(a []string, b []string) float64 {
+	shared := CountSharedTags(a, b)
+	if shared == 0 {
+		return 0
+	}
+	return float64(shared) / float64(len(DistinctTags(a, b)))
+}
+
+// termVectors weighs the terms of the bodies of blogs by TF-IDF, one vector per blog
+func termVectors(blogs []Blog, bodies map[string]string) []map[string]float64 {
+	counts := make([]map[string]int, len(blogs))
+	documents := map[string]int{}
+	for i, blog := range blogs {
+		counts[i] = Terms(bodies[blog.Id])
+		for term := range counts[i] {
+			documents[term]++
+		}
+	}
+
+	vectors := make([]map[string]float64, len(blogs))
+	for i, terms := range counts {
+		vectors[i] = map[string]float64{}
+		for term, count := range terms {
+			// Smoothed so that terms found in every post still count, only less than rarer ones
+			idf := 1 + math.Log(float64(len(blogs))/float64(documents[term]))
+			vectors[i][term] = float64(count) * idf
+		}
+	}
+	return vectors
+}
+
+// cosineSimilarity returns the cosine of the angle between two term vectors, from 0 to 1
+func cosineSimilarity(a map[string]float64, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, weight := range a {
+		dot += weight * b[term]
+		normA += weight * weight
+	}
+	for _, weight := range b {
+		normB += weight * weight
+	}
+	if dot == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// Terms counts the lowercased words of an HTML document, leaving out markup, stop words and short words
+func Terms(document string) map[string]int {
+	terms := map[string]int{}
+	for _, word := range strings.FieldsFunc(html.UnescapeString(stripTags(document)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		word = strings.ToLower(word)
+		if len(word) < 3 || stopWords[word] {
+			continue
+		}
+		terms[word]++
+	}
+	return terms
+}
+
+// CountWords counts the words of an HTML document, leaving out markup and code blocks
+func CountWords(document string) int {
+	count := 0
+	for _, word := range strings.Fields(html.UnescapeString(stripTags(stripCodeBlocks(document)))) {
+		if strings.IndexFunc(word, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// stripCodeBlocks removes the <pre> blocks of an HTML document
+func stripCodeBlocks(document string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(document, "<pre")
+		if start < 0 {
+			break
+		}
+		// Only <pre> and <pre ...>, not other tags starting the same way
+		if rest := document[start+len("<pre"):]; rest != "" && rest[0] != '>' && rest[0] != ' ' {
+			b.WriteString(document[:start+len("<pre")])
+			document = rest
+			continue
+		}
+
+		b.WriteString(document[:start])
+		end := strings.Index(document[start:], "</pre>")
+		if end < 0 {
+			return b.String()
+		}
+		b.WriteRune(' ')
+		document = document[start+end+len("</pre>"):]
+	}
+	b.WriteString(document)
+	return b.String()
+}
+
+// stripTags replaces the tags of an HTML document with spaces
+func stripTags(document string) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range document {
+		switch {
+		case r == '<':
+			inTag = true
+			b.WriteRune(' ')
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
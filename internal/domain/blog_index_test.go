@@ -0,0 +1,60 @@
+package models
+
+import "testing"
+
+func TestBlogIndex(t *testing.T) {
+	blogs := []Blog{
+		{Id: "htmx", PublishedDate: MustParseDate("2025-04-20"), Tags: []string{"Go", "HTMX"}},
+		{Id: "scraper", PublishedDate: MustParseDate("2025-05-04"), Tags: []string{"Python"}},
+		{Id: "templates", PublishedDate: MustParseDate("2024-11-02"), Tags: []string{"go"}},
+		{Id: "batch", PublishedDate: MustParseDate("2024-02-10"), Tags: []string{"Java"}},
+		{Id: "draft"},
+	}
+	bodies := map[string]string{
+		"scraper": "<p>Scraping the booking site with <code>Playwright</code> and a Telegram bot</p>",
+		"batch":   "<h2>Batch</h2><p>Nightly batch jobs, the booking &amp; Telegram notifications</p>",
+	}
+
+	index := NewBlogIndex(blogs, bodies, 2)
+
+	if previous, ok := index.Previous("htmx"); !ok || previous.Id != "templates" {
+		t.Errorf("Previous(htmx) = %q, %v, want templates", previous.Id, ok)
+	}
+	if next, ok := index.Next("htmx"); !ok || next.Id != "scraper" {
+		t.Errorf("Next(htmx) = %q, %v, want scraper", next.Id, ok)
+	}
+	if _, ok := index.Next("scraper"); ok {
+		t.Error("Next(scraper) found a post after the newest one")
+	}
+	if _, ok := index.Previous("draft"); ok {
+		t.Error("Previous(draft) found a neighbour for an unpublished post")
+	}
+
+	if related := index.Related("htmx"); len(related) != 1 || related[0].Id != "templates" {
+		t.Errorf("Related(htmx) = %v, want the post sharing a tag", related)
+	}
+	if related := index.Related("scraper"); len(related) != 1 || related[0].Id != "batch" {
+		t.Errorf("Related(scraper) = %v, want the post sharing body terms", related)
+	}
+}
+
+func TestTerms(t *testing.T) {
+	terms := Terms(`<p class="lead">The <em>booking</em> site &amp; the Booking API</p>`)
+	if terms["booking"] != 2 || terms["api"] != 1 || terms["the"] != 0 || terms["lead"] != 0 {
+		t.Errorf("Terms() = %v, want the words of the text without markup or stop words", terms)
+	}
+}
+
+func TestCountWords(t *testing.T) {
+	document := `<h2 id="setup">Setup</h2><p>Run the <code>make</code> target &mdash; it builds everything.</p>
+<div class="sourceCode"><pre class="sourceCode go"><code>func main() { fmt.Println("ignored") }</code></pre></div>
+<preview>Two more</preview>`
+	if got := CountWords(document); got != 10 {
+		t.Errorf("CountWords() = %d, want 10", got)
+	}
+
+	blog := Blog{WordCount: 401}
+	if got := blog.ReadingMinutes(); got != 3 {
+		t.Errorf("ReadingMinutes() = %d, want 3 for %d words", got, blog.WordCount)
+	}
+}
@@ -1,8 +1,105 @@
 package models
 
+import (
+	"strings"
+	"time"
+)
+
+// States of a certification, see Certification.Status
+const (
+	CertificationActive       = "active"
+	CertificationExpiringSoon = "expiring-soon"
+	CertificationExpired      = "expired"
+)
+
 type Certification struct {
 	Name         string `json:"name"`
 	Issuer       string `json:"issuer"`
-	DateReceived string `json:"dateReceived"`
+	DateReceived Date   `json:"dateReceived"`
+	ExpiryDate   Date   `json:"expiryDate"` // open when the certification does not expire
+	CredentialID string `json:"credentialId,omitempty"`
 	Url          string `json:"url"`
+	// Logo is the issuer logo under the static assets, e.g. "images/issuers/aws.svg"
+	Logo string `json:"logo,omitempty"`
+}
+
+// Expires reports whether the certification has an expiry date
+func (c Certification) Expires() bool {
+	return !c.ExpiryDate.IsOpen()
+}
+
+// ValidUntil returns the first instant the certification is no longer valid
+// A certification stays valid through its whole expiry day, or its whole expiry month at month precision
+func (c Certification) ValidUntil() time.Time {
+	if c.ExpiryDate.MonthOnly {
+		return c.ExpiryDate.AddDate(0, 1, 0)
+	}
+	return c.ExpiryDate.AddDate(0, 0, 1)
+}
+
+// IsExpired reports whether the certification has expired at now
+func (c Certification) IsExpired(now time.Time) bool {
+	return c.Expires() && !now.Before(c.ValidUntil())
+}
+
+// Status returns whether the certification is active, expired, or expiring within the warning window
+func (c Certification) Status(now time.Time, warning time.Duration) string {
+	switch {
+	case !c.Expires():
+		return CertificationActive
+	case c.IsExpired(now):
+		return CertificationExpired
+	case !now.Add(warning).Before(c.ValidUntil()):
+		return CertificationExpiringSoon
+	default:
+		return CertificationActive
+	}
+}
+
+// CompareCertifications orders certifications from the most recently received to the oldest
+func CompareCertifications(a Certification, b Certification) int {
+	return CompareNewestFirst(a.DateReceived, b.DateReceived)
+}
+
+// CompareCertificationsByIssuer orders certifications alphabetically by issuer, most recent first within an issuer
+func CompareCertificationsByIssuer(a Certification, b Certification) int {
+	if c := strings.Compare(strings.ToLower(a.Issuer), strings.ToLower(b.Issuer)); c != 0 {
+		return c
+	}
+	return CompareCertifications(a, b)
+}
+
+// ActiveCertifications returns the certifications that have not expired at now
+func ActiveCertifications(certifications []Certification, now time.Time) []Certification {
+	var active []Certification
+	for _, certification := range certifications {
+		if !certification.IsExpired(now) {
+			active = append(active, certification)
+		}
+	}
+	return active
+}
+
+// CertificationGroup holds the certifications of an issuer
+type CertificationGroup struct {
+	Issuer         string
+	Certifications []Certification
+}
+
+// GroupCertificationsByIssuer groups certifications by issuer ignoring case, keeping their order within an issuer
+// Issuers are listed in the order of their first certification
+func GroupCertificationsByIssuer(certifications []Certification) []CertificationGroup {
+	var groups []CertificationGroup
+	positions := map[string]int{}
+	for _, certification := range certifications {
+		key := strings.ToLower(strings.TrimSpace(certification.Issuer))
+		i, ok := positions[key]
+		if !ok {
+			i = len(groups)
+			positions[key] = i
+			groups = append(groups, CertificationGroup{Issuer: certification.Issuer})
+		}
+		groups[i].Certifications = append(groups[i].Certifications, certification)
+	}
+	return groups
 }
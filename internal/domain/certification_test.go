@@ -0,0 +1,47 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCertificationStatus(t *testing.T) {
+	now := time.Date(2025, time.June, 15, 12, 0, 0, 0, time.UTC)
+	warning := 90 * 24 * time.Hour
+
+	tests := []struct {
+		name   string
+		expiry string
+		want   string
+	}{
+		{"no expiry", "", CertificationActive},
+		{"far expiry", "2027-02-20", CertificationActive},
+		{"within the warning window", "2025-08-01", CertificationExpiringSoon},
+		{"expires today", "2025-06-15", CertificationExpiringSoon},
+		{"expired yesterday", "2025-06-14", CertificationExpired},
+		{"expiry month still running", "2025-06", CertificationExpiringSoon},
+		{"expiry month over", "2025-05", CertificationExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certification := Certification{Name: "Cert", ExpiryDate: MustParseDate(tt.expiry)}
+			if got := certification.Status(now, warning); got != tt.want {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupCertificationsByIssuer(t *testing.T) {
+	certifications := []Certification{
+		{Name: "Developer", Issuer: "AWS"},
+		{Name: "Fundamentals", Issuer: "Microsoft"},
+		{Name: "Architect", Issuer: "aws"},
+	}
+
+	groups := GroupCertificationsByIssuer(certifications)
+	if len(groups) != 2 || groups[0].Issuer != "AWS" || len(groups[0].Certifications) != 2 || groups[1].Issuer != "Microsoft" {
+		t.Errorf("GroupCertificationsByIssuer() = %+v", groups)
+	}
+}
@@ -0,0 +1,187 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Layouts accepted when parsing catalog dates, most precise first
+const (
+	DayLayout   = "2006-01-02"
+	MonthLayout = "2006-01"
+)
+
+// monthLayouts are the human readable month precision layouts found in older catalogs
+var monthLayouts = []string{MonthLayout, "January 2006", "Jan 2006"}
+
+// openDateValues mark an open-ended date such as the end of a current position
+var openDateValues = []string{"", "present", "current"}
+
+// Date is a calendar date read from the catalogs
+// The zero Date is open-ended: it represents "Present" when used as an end date
+type Date struct {
+	time.Time
+	// MonthOnly is set when the source only specified a month and year
+	MonthOnly bool
+}
+
+// ParseDate parses a catalog date in any of the supported layouts
+// Empty strings, "Present" and "Current" yield an open-ended zero Date
+func ParseDate(value string) (Date, error) {
+	value = strings.TrimSpace(value)
+	for _, open := range openDateValues {
+		if strings.EqualFold(value, open) {
+			return Date{}, nil
+		}
+	}
+
+	if t, err := time.Parse(DayLayout, value); err == nil {
+		return Date{Time: t}, nil
+	}
+	for _, layout := range monthLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Date{Time: t, MonthOnly: true}, nil
+		}
+	}
+
+	return Date{}, fmt.Errorf("cannot parse date %q, expected YYYY-MM-DD, YYYY-MM, \"Month YYYY\" or \"Present\"", value)
+}
+
+// MustParseDate is like ParseDate but panics on invalid input, for tests and constants
+func MustParseDate(value string) Date {
+	d, err := ParseDate(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// IsOpen reports whether the date is open-ended
+func (d Date) IsOpen() bool {
+	return d.IsZero()
+}
+
+// String returns the ISO 8601 representation at the date's precision, or "" when open-ended
+func (d Date) String() string {
+	if d.IsOpen() {
+		return ""
+	}
+	if d.MonthOnly {
+		return d.Format(MonthLayout)
+	}
+	return d.Format(DayLayout)
+}
+
+// OrNow returns the date's time, or now when the date is open-ended
+func (d Date) OrNow(now time.Time) time.Time {
+	if d.IsOpen() {
+		return now
+	}
+	return d.Time
+}
+
+// MarshalJSON encodes the date in ISO 8601, with null for open-ended dates
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsOpen() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a date string in any layout accepted by ParseDate
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("date must be a string: %w", err)
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner so dates can be read from text columns
+func (d *Date) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case string:
+		parsed, err := ParseDate(value)
+		*d = parsed
+		return err
+	case []byte:
+		parsed, err := ParseDate(string(value))
+		*d = parsed
+		return err
+	default:
+		return fmt.Errorf("cannot scan %T into Date", src)
+	}
+}
+
+// Value implements driver.Valuer, storing dates as ISO 8601 text
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// CompareNewestFirst orders dates from newest to oldest
+// Open-ended dates are treated as the most recent
+func CompareNewestFirst(a Date, b Date) int {
+	switch {
+	case a.IsOpen() && b.IsOpen():
+		return 0
+	case a.IsOpen():
+		return -1
+	case b.IsOpen():
+		return 1
+	}
+	return b.Compare(a.Time)
+}
+
+// Tenure is a duration expressed in whole years and months
+type Tenure struct {
+	Years  int `json:"years"`
+	Months int `json:"months"`
+}
+
+// TotalMonths returns the tenure expressed in months
+func (t Tenure) TotalMonths() int {
+	return t.Years*12 + t.Months
+}
+
+// Add returns the sum of two tenures
+func (t Tenure) Add(other Tenure) Tenure {
+	return TenureFromMonths(t.TotalMonths() + other.TotalMonths())
+}
+
+// TenureFromMonths normalizes a month count into years and months
+func TenureFromMonths(months int) Tenure {
+	if months < 0 {
+		months = 0
+	}
+	return Tenure{Years: months / 12, Months: months % 12}
+}
+
+// TenureBetween counts the calendar months from start to end, both months included,
+// the way résumés usually present it ("March 2025 - August 2025" is 6 months)
+// An open-ended end is measured up to now
+func TenureBetween(start Date, end Date, now time.Time) Tenure {
+	if start.IsOpen() {
+		return Tenure{}
+	}
+	until := end.OrNow(now)
+	months := (until.Year()-start.Year())*12 + int(until.Month()) - int(start.Month()) + 1
+	return TenureFromMonths(months)
+}
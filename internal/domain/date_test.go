@@ -0,0 +1,105 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectOpen    bool
+		expectMonthly bool
+		expectError   bool
+	}{
+		{name: "ISO day", input: "2025-05-04", expected: "2025-05-04"},
+		{name: "ISO month", input: "2025-08", expected: "2025-08", expectMonthly: true},
+		{name: "Month name", input: "August 2025", expected: "2025-08", expectMonthly: true},
+		{name: "Present", input: "Present", expectOpen: true},
+		{name: "Current", input: "current", expectOpen: true},
+		{name: "Empty", input: "", expectOpen: true},
+		{name: "Invalid", input: "04/05/2025", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDate(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("ParseDate(%q) error = %v, expectError %v", tt.input, err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if d.IsOpen() != tt.expectOpen {
+				t.Errorf("ParseDate(%q).IsOpen() = %v, want %v", tt.input, d.IsOpen(), tt.expectOpen)
+			}
+			if d.MonthOnly != tt.expectMonthly {
+				t.Errorf("ParseDate(%q).MonthOnly = %v, want %v", tt.input, d.MonthOnly, tt.expectMonthly)
+			}
+			if d.String() != tt.expected {
+				t.Errorf("ParseDate(%q).String() = %q, want %q", tt.input, d.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestDateJSONRoundTrip(t *testing.T) {
+	var experience WorkExperience
+	err := json.Unmarshal([]byte(`{"startDate": "March 2025", "endDate": "Current"}`), &experience)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	data, err := json.Marshal(struct {
+		Start Date `json:"start"`
+		End   Date `json:"end"`
+	}{experience.StartDate, experience.EndDate})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if got, want := string(data), `{"start":"2025-03","end":null}`; got != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestTenureBetween(t *testing.T) {
+	now := time.Date(2025, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		expected Tenure
+	}{
+		{name: "Same month", start: "March 2025", end: "March 2025", expected: Tenure{Months: 1}},
+		{name: "Closed range", start: "March 2025", end: "August 2025", expected: Tenure{Months: 6}},
+		{name: "Over a year", start: "November 2021", end: "July 2023", expected: Tenure{Years: 1, Months: 9}},
+		{name: "Open ended", start: "August 2024", end: "Present", expected: Tenure{Years: 1, Months: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TenureBetween(MustParseDate(tt.start), MustParseDate(tt.end), now)
+			if got != tt.expected {
+				t.Errorf("TenureBetween() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompareWorkExperiences(t *testing.T) {
+	current := WorkExperience{JobTitle: "current", StartDate: MustParseDate("2025-08")}
+	recent := WorkExperience{JobTitle: "recent", StartDate: MustParseDate("2025-03"), EndDate: MustParseDate("2025-08")}
+	old := WorkExperience{JobTitle: "old", StartDate: MustParseDate("2021-11"), EndDate: MustParseDate("2023-07")}
+
+	if CompareWorkExperiences(current, recent) >= 0 {
+		t.Error("current position should sort before a finished one")
+	}
+	if CompareWorkExperiences(recent, old) >= 0 {
+		t.Error("recent position should sort before an older one")
+	}
+}
@@ -0,0 +1,75 @@
+package models
+
+import "sort"
+
+// Kinds of favorites shown on the reading list, in display order
+const (
+	FavoriteKindBook    = "book"
+	FavoriteKindArticle = "article"
+	FavoriteKindTool    = "tool"
+	FavoriteKindOther   = "other"
+)
+
+// FavoriteKinds lists the known favorite kinds in the order they are displayed
+var FavoriteKinds = []string{FavoriteKindBook, FavoriteKindArticle, FavoriteKindTool, FavoriteKindOther}
+
+type Favorite struct {
+	Title     string `json:"title"`
+	Url       string `json:"url"`
+	Kind      string `json:"kind"`
+	Note      string `json:"note"`
+	AddedDate Date   `json:"addedDate"`
+}
+
+// CompareFavorites orders favorites from the most recently added to the oldest
+// Favorites without an added date are listed last
+func CompareFavorites(a Favorite, b Favorite) int {
+	switch {
+	case a.AddedDate.IsOpen() && b.AddedDate.IsOpen():
+		return 0
+	case a.AddedDate.IsOpen():
+		return 1
+	case b.AddedDate.IsOpen():
+		return -1
+	}
+	return CompareNewestFirst(a.AddedDate, b.AddedDate)
+}
+
+// FavoriteGroup holds every favorite of the same kind
+type FavoriteGroup struct {
+	Kind      string
+	Favorites []Favorite
+}
+
+// GroupFavoritesByKind groups favorites by kind, keeping catalog order within a group
+// Known kinds come first in FavoriteKinds order, unknown kinds follow alphabetically
+// Favorites without a kind are grouped under FavoriteKindOther
+func GroupFavoritesByKind(favorites []Favorite) []FavoriteGroup {
+	byKind := map[string][]Favorite{}
+	for _, favorite := range favorites {
+		kind := favorite.Kind
+		if kind == "" {
+			kind = FavoriteKindOther
+		}
+		byKind[kind] = append(byKind[kind], favorite)
+	}
+
+	var groups []FavoriteGroup
+	for _, kind := range FavoriteKinds {
+		if items, ok := byKind[kind]; ok {
+			groups = append(groups, FavoriteGroup{Kind: kind, Favorites: items})
+			delete(byKind, kind)
+		}
+	}
+
+	var unknown []string
+	for kind := range byKind {
+		unknown = append(unknown, kind)
+	}
+	sort.Strings(unknown)
+	for _, kind := range unknown {
+		groups = append(groups, FavoriteGroup{Kind: kind, Favorites: byKind[kind]})
+	}
+
+	return groups
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompareFavorites(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{"newer first", "2025-03-01", "2024-11-20", -1},
+		{"older last", "2024-11-20", "2025-03-01", 1},
+		{"same day tie", "2025-03-01", "2025-03-01", 0},
+		{"missing date last", "", "2024-11-20", 1},
+		{"dated before missing", "2024-11-20", "", -1},
+		{"both missing tie", "", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Favorite{Title: "A", AddedDate: MustParseDate(tt.a)}
+			b := Favorite{Title: "B", AddedDate: MustParseDate(tt.b)}
+			if got := CompareFavorites(a, b); got != tt.want {
+				t.Errorf("CompareFavorites(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	// Ties keep the catalog order under a stable sort
+	favorites := []Favorite{
+		{Title: "Undated"},
+		{Title: "First", AddedDate: MustParseDate("2025-01-10")},
+		{Title: "Second", AddedDate: MustParseDate("2025-01-10")},
+	}
+	slices.SortStableFunc(favorites, CompareFavorites)
+	if got := []string{favorites[0].Title, favorites[1].Title, favorites[2].Title}; !slices.Equal(got, []string{"First", "Second", "Undated"}) {
+		t.Errorf("sorted favorites = %v, want [First Second Undated]", got)
+	}
+}
+
+func TestGroupFavoritesByKind(t *testing.T) {
+	tests := []struct {
+		name      string
+		favorites []Favorite
+		want      map[string][]string // kind to titles
+		order     []string
+	}{
+		{
+			name: "known kinds in display order",
+			favorites: []Favorite{
+				{Title: "Hammer", Kind: FavoriteKindTool},
+				{Title: "Essay", Kind: FavoriteKindArticle},
+				{Title: "Novel", Kind: FavoriteKindBook},
+				{Title: "Manual", Kind: FavoriteKindBook},
+			},
+			want:  map[string][]string{FavoriteKindBook: {"Novel", "Manual"}, FavoriteKindArticle: {"Essay"}, FavoriteKindTool: {"Hammer"}},
+			order: []string{FavoriteKindBook, FavoriteKindArticle, FavoriteKindTool},
+		},
+		{
+			name: "missing kind grouped under other",
+			favorites: []Favorite{
+				{Title: "Loose"},
+				{Title: "Misc", Kind: FavoriteKindOther},
+			},
+			want:  map[string][]string{FavoriteKindOther: {"Loose", "Misc"}},
+			order: []string{FavoriteKindOther},
+		},
+		{
+			name: "unknown kinds after known ones, alphabetically",
+			favorites: []Favorite{
+				{Title: "Episode", Kind: "podcast"},
+				{Title: "Clip", Kind: "film"},
+				{Title: "Loose"},
+			},
+			want:  map[string][]string{FavoriteKindOther: {"Loose"}, "film": {"Clip"}, "podcast": {"Episode"}},
+			order: []string{FavoriteKindOther, "film", "podcast"},
+		},
+		{
+			name:  "no favorites",
+			want:  map[string][]string{},
+			order: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := GroupFavoritesByKind(tt.favorites)
+
+			var order []string
+			for _, group := range groups {
+				order = append(order, group.Kind)
+				var titles []string
+				for _, favorite := range group.Favorites {
+					titles = append(titles, favorite.Title)
+				}
+				if !slices.Equal(titles, tt.want[group.Kind]) {
+					t.Errorf("group %q = %v, want %v", group.Kind, titles, tt.want[group.Kind])
+				}
+			}
+			if !slices.Equal(order, tt.order) {
+				t.Errorf("kinds = %v, want %v", order, tt.order)
+			}
+		})
+	}
+}
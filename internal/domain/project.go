@@ -1,8 +1,128 @@
 package models
 
+import (
+	"slices"
+	"strings"
+)
+
+// Statuses of a project
+const (
+	ProjectActive   = "active"
+	ProjectArchived = "archived"
+)
+
+// ProjectStatuses lists the known project statuses
+var ProjectStatuses = []string{ProjectActive, ProjectArchived}
+
 type Project struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Link        string   `json:"link"`
-	Tags        []string `json:"tags"`
+	// Slug names the project in /project/{slug}, derived from the name when empty, see ID
+	Slug        string       `json:"slug,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Link        string       `json:"link"`
+	Tags        []string     `json:"tags"`
+	Status      string       `json:"status,omitempty"`
+	Role        string       `json:"role,omitempty"`
+	StartDate   Date         `json:"startDate"` // open when unknown
+	EndDate     Date         `json:"endDate"`   // open while the project is ongoing
+	Screenshots []Screenshot `json:"screenshots,omitempty"`
+
+	// Repository is filled at runtime from the code host the Link points to, nil when unknown
+	Repository *RepositoryMetadata `json:"repository,omitempty"`
+	// AddedDate is filled at runtime with the day the project was added to the catalog, open when unknown
+	AddedDate Date `json:"-"`
+}
+
+// RepositoryMetadata describes the code repository of a project as reported by its code host
+type RepositoryMetadata struct {
+	FullName   string `json:"fullName"` // e.g. "aHobeychi/Personal-Website"
+	Stars      int    `json:"stars"`
+	Language   string `json:"language,omitempty"` // primary language
+	Created    Date   `json:"created"`            // creation of the repository
+	LastCommit Date   `json:"lastCommit"`         // latest commit on the default branch
+	Archived   bool   `json:"archived"`
+}
+
+// Screenshot is an image of a project under the static assets
+type Screenshot struct {
+	Src     string `json:"src"` // e.g. "images/projects/personal-website.png"
+	Alt     string `json:"alt"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// ID returns the slug of the project, derived from its name when none is set
+func (p Project) ID() string {
+	if p.Slug != "" {
+		return p.Slug
+	}
+	return Slugify(p.Name)
+}
+
+// Since returns when the project began: its start date, else the creation of its repository,
+// else the day it was added to the catalog, open when none is known
+func (p Project) Since() Date {
+	switch {
+	case !p.StartDate.IsOpen():
+		return p.StartDate
+	case p.Repository != nil && !p.Repository.Created.IsOpen():
+		return p.Repository.Created
+	default:
+		return p.AddedDate
+	}
+}
+
+// IsArchived reports whether the project is no longer maintained, as set in the catalog or by its code host
+func (p Project) IsArchived() bool {
+	return strings.EqualFold(p.Status, ProjectArchived) || (p.Repository != nil && p.Repository.Archived)
+}
+
+// HasTag reports whether the project is tagged with tag, ignoring case
+func (p Project) HasTag(tag string) bool {
+	return hasTag(p.Tags, tag)
+}
+
+// Matches reports whether the name, description or tags contain the search text
+func (p Project) Matches(text string) bool {
+	return containsText(text, p.Name, p.Description, strings.Join(p.Tags, " "))
+}
+
+// CompareProjectsByName orders projects alphabetically by name
+func CompareProjectsByName(a Project, b Project) int {
+	return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
+
+// FindProject returns the project whose ID is slug, ignoring case
+func FindProject(projects []Project, slug string) (Project, bool) {
+	for _, project := range projects {
+		if strings.EqualFold(project.ID(), slug) {
+			return project, true
+		}
+	}
+	return Project{}, false
+}
+
+// BlogsSharingTags returns up to limit blogs sharing at least one tag, most shared tags first then newest
+func BlogsSharingTags(tags []string, blogs []Blog, limit int) []Blog {
+	type match struct {
+		blog   Blog
+		shared int
+	}
+	var matches []match
+	for _, blog := range blogs {
+		if shared := CountSharedTags(tags, blog.Tags); shared > 0 {
+			matches = append(matches, match{blog, shared})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b match) int {
+		if a.shared != b.shared {
+			return b.shared - a.shared
+		}
+		return CompareBlogs(a.blog, b.blog)
+	})
+
+	related := make([]Blog, 0, min(limit, len(matches)))
+	for _, m := range matches[:min(limit, len(matches))] {
+		related = append(related, m.blog)
+	}
+	return related
 }
@@ -0,0 +1,34 @@
+package models
+
+import "testing"
+
+func TestFindProject(t *testing.T) {
+	projects := []Project{
+		{Name: "Personal Website"},
+		{Name: "Go Compiler", Slug: "compiler"},
+	}
+
+	if project, ok := FindProject(projects, "personal-website"); !ok || project.Name != "Personal Website" {
+		t.Errorf("FindProject(personal-website) = %+v, %v, want the slug derived from the name", project, ok)
+	}
+	if project, ok := FindProject(projects, "Compiler"); !ok || project.Name != "Go Compiler" {
+		t.Errorf("FindProject(Compiler) = %+v, %v, want the explicit slug ignoring case", project, ok)
+	}
+	if _, ok := FindProject(projects, "go-compiler"); ok {
+		t.Error("FindProject(go-compiler) found a project hidden by its explicit slug")
+	}
+}
+
+func TestBlogsSharingTags(t *testing.T) {
+	blogs := []Blog{
+		{Id: "old", PublishedDate: MustParseDate("2024-01-10"), Tags: []string{"Go"}},
+		{Id: "both", PublishedDate: MustParseDate("2023-05-01"), Tags: []string{"go", "HTMX"}},
+		{Id: "new", PublishedDate: MustParseDate("2025-03-02"), Tags: []string{"Go"}},
+		{Id: "unrelated", PublishedDate: MustParseDate("2025-04-01"), Tags: []string{"Python"}},
+	}
+
+	related := BlogsSharingTags([]string{"Go", "HTMX"}, blogs, 2)
+	if len(related) != 2 || related[0].Id != "both" || related[1].Id != "new" {
+		t.Errorf("BlogsSharingTags() = %+v, want both then new", related)
+	}
+}
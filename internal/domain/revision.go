@@ -0,0 +1,62 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Revision is a commit that changed a content file or catalog entry
+type Revision struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Time    time.Time `json:"time"`
+	Subject string    `json:"subject"`
+}
+
+// ShortHash returns the abbreviated commit hash, for display
+func (r Revision) ShortHash() string {
+	return r.Hash[:min(7, len(r.Hash))]
+}
+
+// Date returns the day of the commit, in UTC
+func (r Revision) Date() Date {
+	year, month, day := r.Time.UTC().Date()
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// Revisions is the history of a piece of content, newest first
+type Revisions []Revision
+
+// Created returns the time of the oldest revision, zero when there is none
+func (r Revisions) Created() time.Time {
+	if len(r) == 0 {
+		return time.Time{}
+	}
+	return r[len(r)-1].Time
+}
+
+// Modified returns the time of the newest revision, zero when there is none
+func (r Revisions) Modified() time.Time {
+	if len(r) == 0 {
+		return time.Time{}
+	}
+	return r[0].Time
+}
+
+// MergeRevisions merges histories into one, newest first, keeping commits found in several of them once
+func MergeRevisions(histories ...Revisions) Revisions {
+	var merged Revisions
+	seen := map[string]bool{}
+	for _, history := range histories {
+		for _, revision := range history {
+			if !seen[revision.Hash] {
+				seen[revision.Hash] = true
+				merged = append(merged, revision)
+			}
+		}
+	}
+	slices.SortStableFunc(merged, func(a, b Revision) int {
+		return b.Time.Compare(a.Time)
+	})
+	return merged
+}
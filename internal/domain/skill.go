@@ -0,0 +1,165 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// SkillCategoryOther holds the skills missing from every configured category
+const SkillCategoryOther = "Other"
+
+// Skill aggregates the uses of a tag across work experience, projects and blogs
+type Skill struct {
+	Name        string `json:"name"`
+	Category    string `json:"category"`
+	Tenure      Tenure `json:"tenure"`  // hands-on time from the work experience, overlapping roles counted once
+	Current     bool   `json:"current"` // used in a current role
+	Experiences int    `json:"experiences"`
+	Projects    int    `json:"projects"`
+	Blogs       int    `json:"blogs"`
+}
+
+// Uses returns the number of roles, projects and blogs tagged with the skill
+func (s Skill) Uses() int {
+	return s.Experiences + s.Projects + s.Blogs
+}
+
+// CompareSkills orders skills from the longest hands-on use to the shortest, then by number of uses and name
+func CompareSkills(a Skill, b Skill) int {
+	if c := b.Tenure.TotalMonths() - a.Tenure.TotalMonths(); c != 0 {
+		return c
+	}
+	if c := b.Uses() - a.Uses(); c != 0 {
+		return c
+	}
+	return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
+
+// SkillGroup holds the skills of a category
+type SkillGroup struct {
+	Category string  `json:"category"`
+	Skills   []Skill `json:"skills"`
+}
+
+// SkillTaxonomy maps tags to skills, aliases rename tags and categories group the resulting skills
+type SkillTaxonomy struct {
+	aliases    map[string]string // lower case alias to skill name
+	names      map[string]string // lower case skill name to its configured spelling
+	categories map[string]string // lower case skill name to its category
+	order      []string          // categories in display order
+}
+
+// NewSkillTaxonomy creates a taxonomy renaming the tags found in aliases, ignoring case
+func NewSkillTaxonomy(aliases map[string]string) SkillTaxonomy {
+	t := SkillTaxonomy{aliases: map[string]string{}, names: map[string]string{}, categories: map[string]string{}}
+	for alias, name := range aliases {
+		t.aliases[strings.ToLower(strings.TrimSpace(alias))] = name
+	}
+	return t
+}
+
+// AddCategory files skills under a category, categories are displayed in the order they are added
+func (t SkillTaxonomy) AddCategory(category string, skills ...string) SkillTaxonomy {
+	t.order = append(t.order, category)
+	for _, skill := range skills {
+		key := strings.ToLower(strings.TrimSpace(skill))
+		t.names[key] = skill
+		t.categories[key] = category
+	}
+	return t
+}
+
+// Resolve returns the skill name and category of a tag
+// Tags neither aliased nor listed in a category keep their spelling and fall under SkillCategoryOther
+func (t SkillTaxonomy) Resolve(tag string) (string, string) {
+	name := strings.TrimSpace(tag)
+	if alias, ok := t.aliases[strings.ToLower(name)]; ok {
+		name = alias
+	}
+
+	key := strings.ToLower(name)
+	if spelling, ok := t.names[key]; ok {
+		name = spelling
+	}
+	category, ok := t.categories[key]
+	if !ok {
+		category = SkillCategoryOther
+	}
+	return name, category
+}
+
+// Skills indexes the tags of the work experience, projects and blogs, most used first
+// The tenure of a skill covers the roles tagged with it, current roles are measured up to now
+func (t SkillTaxonomy) Skills(experiences []WorkExperience, projects []Project, blogs []Blog, now time.Time) []Skill {
+	index := map[string]*Skill{}
+	roles := map[string][]WorkExperience{}
+	var keys []string
+
+	// use records a tagged item once per skill, even when several of its tags resolve to the same skill
+	use := func(tags []string, count func(*Skill)) []string {
+		var used []string
+		for _, tag := range tags {
+			if strings.TrimSpace(tag) == "" {
+				continue
+			}
+			name, category := t.Resolve(tag)
+			key := strings.ToLower(name)
+			if slices.Contains(used, key) {
+				continue
+			}
+			used = append(used, key)
+
+			skill, ok := index[key]
+			if !ok {
+				skill = &Skill{Name: name, Category: category}
+				index[key] = skill
+				keys = append(keys, key)
+			}
+			count(skill)
+		}
+		return used
+	}
+
+	for _, experience := range experiences {
+		for _, key := range use(experience.Tags, func(s *Skill) { s.Experiences++ }) {
+			roles[key] = append(roles[key], experience)
+			if experience.IsCurrent() {
+				index[key].Current = true
+			}
+		}
+	}
+	for _, project := range projects {
+		use(project.Tags, func(s *Skill) { s.Projects++ })
+	}
+	for _, blog := range blogs {
+		use(blog.Tags, func(s *Skill) { s.Blogs++ })
+	}
+
+	skills := make([]Skill, 0, len(keys))
+	for _, key := range keys {
+		skill := *index[key]
+		skill.Tenure = tenureOf(roles[key], now)
+		skills = append(skills, skill)
+	}
+	slices.SortStableFunc(skills, CompareSkills)
+	return skills
+}
+
+// Group groups skills by category, keeping their order within a category
+// Categories come in the order they were added, followed by SkillCategoryOther
+func (t SkillTaxonomy) Group(skills []Skill) []SkillGroup {
+	byCategory := map[string][]Skill{}
+	for _, skill := range skills {
+		byCategory[skill.Category] = append(byCategory[skill.Category], skill)
+	}
+
+	var groups []SkillGroup
+	for _, category := range append(slices.Clone(t.order), SkillCategoryOther) {
+		if items, ok := byCategory[category]; ok {
+			groups = append(groups, SkillGroup{Category: category, Skills: items})
+			delete(byCategory, category)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSkillTaxonomy(t *testing.T) {
+	taxonomy := NewSkillTaxonomy(map[string]string{"golang": "Go"}).
+		AddCategory("Languages", "Go", "Python").
+		AddCategory("Cloud", "AWS Lambda")
+
+	experiences := []WorkExperience{
+		{JobTitle: "Lead", StartDate: MustParseDate("2024-01"), Tags: []string{"Go", "AWS Lambda"}},
+		{JobTitle: "Developer", StartDate: MustParseDate("2022-01"), EndDate: MustParseDate("2024-03"), Tags: []string{"golang", "go"}},
+	}
+	projects := []Project{{Name: "Site", Tags: []string{"Golang", "HTMX"}}}
+	blogs := []Blog{{Id: "notes", Tags: []string{"python", "HTMX"}}}
+
+	now := time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC)
+	groups := taxonomy.Group(taxonomy.Skills(experiences, projects, blogs, now))
+
+	if len(groups) != 3 || groups[0].Category != "Languages" || groups[1].Category != "Cloud" || groups[2].Category != SkillCategoryOther {
+		t.Fatalf("Group() = %+v, want Languages, Cloud and Other", groups)
+	}
+
+	golang := groups[0].Skills[0]
+	if golang.Name != "Go" || golang.Experiences != 2 || golang.Projects != 1 || !golang.Current {
+		t.Errorf("Go = %+v, want both roles counted once each and the project through its alias", golang)
+	}
+	// January 2022 to June 2025 with the overlapping months of 2024 counted once
+	if golang.Tenure != (Tenure{Years: 3, Months: 6}) {
+		t.Errorf("Go tenure = %+v, want 3 years 6 months", golang.Tenure)
+	}
+
+	python := groups[0].Skills[1]
+	if python.Name != "Python" || python.Blogs != 1 || python.Tenure.TotalMonths() != 0 {
+		t.Errorf("Python = %+v, want the configured spelling and no time at work", python)
+	}
+
+	htmx := groups[2].Skills[0]
+	if htmx.Name != "HTMX" || htmx.Uses() != 2 {
+		t.Errorf("HTMX = %+v, want an uncategorized skill used twice", htmx)
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// hasTag reports whether tags contains tag, ignoring case
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsText reports whether any of the fields contains the search text, ignoring case
+func containsText(text string, fields ...string) bool {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" {
+		return true
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// DistinctTags returns every tag used across the tag lists, sorted alphabetically
+// Tags differing only by case are merged, keeping the first spelling encountered
+func DistinctTags(tagLists ...[]string) []string {
+	seen := map[string]bool{}
+	var tags []string
+	for _, list := range tagLists {
+		for _, tag := range list {
+			key := strings.ToLower(tag)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			tags = append(tags, tag)
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return strings.ToLower(tags[i]) < strings.ToLower(tags[j])
+	})
+	return tags
+}
+
+// Slugify lowers text and joins its letters and digits with dashes for use in URLs and file names
+// "AllCaughtUp.tech - WIP" becomes "allcaughtup-tech-wip"
+func Slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// CountSharedTags counts the tags of a also found in b, ignoring case
+func CountSharedTags(a []string, b []string) int {
+	count := 0
+	for _, tag := range DistinctTags(a) {
+		if hasTag(b, tag) {
+			count++
+		}
+	}
+	return count
+}
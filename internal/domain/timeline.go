@@ -0,0 +1,128 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// Kinds of timeline entries, one per catalog
+const (
+	TimelineBlog          = "blog"
+	TimelineProject       = "project"
+	TimelineJob           = "job"
+	TimelineCertification = "certification"
+)
+
+// TimelineKinds lists the kinds of timeline entries in the order they are offered as filters
+var TimelineKinds = []string{TimelineBlog, TimelineProject, TimelineJob, TimelineCertification}
+
+// TimelineEntry is a dated event of the timeline, taken from one of the catalogs
+type TimelineEntry struct {
+	Kind        string   `json:"kind"`
+	Title       string   `json:"title"`
+	Subtitle    string   `json:"subtitle,omitempty"` // company of a job, issuer of a certification
+	Description string   `json:"description,omitempty"`
+	Date        Date     `json:"date"`
+	Path        string   `json:"path,omitempty"` // site path of the entry, e.g. "/blog/{id}"
+	Link        string   `json:"link,omitempty"` // external link, e.g. a certification badge
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// HasTag reports whether the entry is tagged with tag, ignoring case
+func (e TimelineEntry) HasTag(tag string) bool {
+	return hasTag(e.Tags, tag)
+}
+
+// TimelineYear holds the entries of a calendar year, newest first
+type TimelineYear struct {
+	Year    int             `json:"year"`
+	Entries []TimelineEntry `json:"entries"`
+}
+
+// NewTimeline merges the catalogs into a single list of entries, newest first
+// Blogs are dated by publication, projects by Project.Since, jobs by their start and certifications by the date received
+// Projects without any known date are left out
+func NewTimeline(blogs []Blog, projects []Project, experiences []WorkExperience, certifications []Certification) []TimelineEntry {
+	var entries []TimelineEntry
+	for _, blog := range blogs {
+		entries = append(entries, TimelineEntry{
+			Kind:        TimelineBlog,
+			Title:       blog.Title,
+			Description: blog.Description,
+			Date:        blog.PublishedDate,
+			Path:        "/blog/" + blog.Id,
+			Tags:        blog.Tags,
+		})
+	}
+	for _, project := range projects {
+		since := project.Since()
+		if since.IsOpen() {
+			continue
+		}
+		entries = append(entries, TimelineEntry{
+			Kind:        TimelineProject,
+			Title:       project.Name,
+			Description: project.Description,
+			Date:        since,
+			Path:        "/project/" + project.ID(),
+			Tags:        project.Tags,
+		})
+	}
+	for _, experience := range experiences {
+		entries = append(entries, TimelineEntry{
+			Kind:        TimelineJob,
+			Title:       experience.JobTitle,
+			Subtitle:    experience.CompanyName,
+			Description: experience.Description,
+			Date:        experience.StartDate,
+			Path:        "/resume",
+			Tags:        experience.Tags,
+		})
+	}
+	for _, certification := range certifications {
+		entries = append(entries, TimelineEntry{
+			Kind:     TimelineCertification,
+			Title:    certification.Name,
+			Subtitle: certification.Issuer,
+			Date:     certification.DateReceived,
+			Link:     certification.Url,
+		})
+	}
+
+	slices.SortStableFunc(entries, func(a, b TimelineEntry) int {
+		return CompareNewestFirst(a.Date, b.Date)
+	})
+	return entries
+}
+
+// FilterTimeline returns the entries of a kind carrying a tag, an empty kind or tag matches every entry
+func FilterTimeline(entries []TimelineEntry, kind string, tag string) []TimelineEntry {
+	var filtered []TimelineEntry
+	for _, entry := range entries {
+		if kind != "" && !strings.EqualFold(entry.Kind, kind) {
+			continue
+		}
+		if tag != "" && !entry.HasTag(tag) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
+
+// GroupTimelineByYear groups entries sorted newest first by calendar year
+// Undated entries are left out
+func GroupTimelineByYear(entries []TimelineEntry) []TimelineYear {
+	var years []TimelineYear
+	for _, entry := range entries {
+		if entry.Date.IsOpen() {
+			continue
+		}
+		year := entry.Date.Year()
+		if len(years) == 0 || years[len(years)-1].Year != year {
+			years = append(years, TimelineYear{Year: year})
+		}
+		years[len(years)-1].Entries = append(years[len(years)-1].Entries, entry)
+	}
+	return years
+}
@@ -0,0 +1,32 @@
+package models
+
+import "testing"
+
+func TestTimeline(t *testing.T) {
+	blogs := []Blog{{Id: "notes", Title: "Notes", PublishedDate: MustParseDate("2025-04-20"), Tags: []string{"Go"}}}
+	// Catalog projects carry no start date, they are dated by their repository or their addition to the catalog
+	projects := []Project{
+		{Name: "Site", Link: "https://github.com/owner/site", Tags: []string{"go", "HTMX"}, Repository: &RepositoryMetadata{Created: MustParseDate("2024-06-12")}},
+		{Name: "Newsletter", Link: "https://example.com", Tags: []string{"Next.js"}, AddedDate: MustParseDate("2022-09-30")},
+		{Name: "Undated", Link: "https://example.org"},
+	}
+	experiences := []WorkExperience{{JobTitle: "Developer", CompanyName: "Co", StartDate: MustParseDate("2024-01"), Tags: []string{"Java"}}}
+	certifications := []Certification{{Name: "Cert", Issuer: "AWS", DateReceived: MustParseDate("2023-02-03")}}
+
+	entries := NewTimeline(blogs, projects, experiences, certifications)
+	if len(entries) != 5 || entries[0].Kind != TimelineBlog || entries[1].Path != "/project/site" || entries[3].Kind != TimelineCertification || entries[4].Path != "/project/newsletter" {
+		t.Fatalf("NewTimeline() = %+v, want the five dated entries newest first", entries)
+	}
+
+	years := GroupTimelineByYear(entries)
+	if len(years) != 4 || years[0].Year != 2025 || years[1].Year != 2024 || len(years[1].Entries) != 2 || years[2].Year != 2023 || years[3].Year != 2022 {
+		t.Errorf("GroupTimelineByYear() = %+v, want 2025, 2024 with two entries, 2023 and 2022", years)
+	}
+
+	if filtered := FilterTimeline(entries, "", "GO"); len(filtered) != 2 {
+		t.Errorf("FilterTimeline(tag GO) = %+v, want the note and the project", filtered)
+	}
+	if filtered := FilterTimeline(entries, TimelineJob, ""); len(filtered) != 1 || filtered[0].Subtitle != "Co" {
+		t.Errorf("FilterTimeline(job) = %+v, want the job", filtered)
+	}
+}
@@ -1,10 +1,244 @@
 package models
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Employment types of a role
+const (
+	EmploymentFullTime     = "full-time"
+	EmploymentPartTime     = "part-time"
+	EmploymentContract     = "contract"
+	EmploymentFreelance    = "freelance"
+	EmploymentInternship   = "internship"
+	EmploymentSelfEmployed = "self-employed"
+)
+
+// EmploymentTypes lists the known employment types
+var EmploymentTypes = []string{EmploymentFullTime, EmploymentPartTime, EmploymentContract, EmploymentFreelance, EmploymentInternship, EmploymentSelfEmployed}
+
+// WorkExperience is a role held at a company
+// Several roles at the same company are grouped for display with GroupByCompany
 type WorkExperience struct {
-	JobTitle    string   `json:"jobTitle"`
-	CompanyName string   `json:"companyName"`
-	Description string   `json:"description"`
-	StartDate   string   `json:"startDate"`
-	EndDate     string   `json:"endDate"`
-	Tags        []string `json:"tags"`
+	JobTitle       string        `json:"jobTitle"`
+	CompanyName    string        `json:"companyName"`
+	Description    string        `json:"description"`
+	StartDate      Date          `json:"startDate"`
+	EndDate        Date          `json:"endDate"`
+	Tags           []string      `json:"tags"`
+	Location       string        `json:"location,omitempty"`
+	Remote         bool          `json:"remote,omitempty"`
+	EmploymentType string        `json:"employmentType,omitempty"`
+	Achievements   []Achievement `json:"achievements,omitempty"`
+}
+
+// IsCurrent reports whether the position is still ongoing
+func (w WorkExperience) IsCurrent() bool {
+	return w.EndDate.IsOpen()
+}
+
+// Tenure returns how long the position lasted, up to today for a current position
+func (w WorkExperience) Tenure() Tenure {
+	return TenureBetween(w.StartDate, w.EndDate, time.Now())
+}
+
+// Workplace describes where the role was held, e.g. "Montreal, QC · Remote"
+func (w WorkExperience) Workplace() string {
+	var parts []string
+	if w.Location != "" {
+		parts = append(parts, w.Location)
+	}
+	if w.Remote {
+		parts = append(parts, "Remote")
+	}
+	return strings.Join(parts, " · ")
+}
+
+// CompareWorkExperiences orders positions from the most recent to the oldest
+// Current positions come first, then positions are ordered by end and start date
+func CompareWorkExperiences(a WorkExperience, b WorkExperience) int {
+	if c := CompareNewestFirst(a.EndDate, b.EndDate); c != 0 {
+		return c
+	}
+	return CompareNewestFirst(a.StartDate, b.StartDate)
+}
+
+// Achievement is an accomplishment of a role, optionally backed by a metric such as "40% faster"
+type Achievement struct {
+	Text   string `json:"text"`
+	Metric string `json:"metric,omitempty"`
+}
+
+// String returns the achievement with its metric, e.g. "Reduced batch runtime (40% faster)"
+func (a Achievement) String() string {
+	if a.Metric == "" {
+		return a.Text
+	}
+	return a.Text + " (" + a.Metric + ")"
+}
+
+// UnmarshalJSON accepts an achievement written as a plain string as well as an object
+func (a *Achievement) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		*a = Achievement{}
+		return json.Unmarshal(data, &a.Text)
+	}
+
+	type achievement Achievement
+	return json.Unmarshal(data, (*achievement)(a))
+}
+
+// WorkExperienceGroup is the nested catalog shape of several roles at one company
+// The company details are copied to every role that does not set its own
+type WorkExperienceGroup struct {
+	CompanyName    string           `json:"companyName"`
+	Location       string           `json:"location"`
+	Remote         bool             `json:"remote"`
+	EmploymentType string           `json:"employmentType"`
+	Roles          []WorkExperience `json:"roles"`
+}
+
+// Flatten returns the roles of the group with the company details filled in
+func (g WorkExperienceGroup) Flatten() []WorkExperience {
+	roles := make([]WorkExperience, len(g.Roles))
+	for i, role := range g.Roles {
+		if role.CompanyName == "" {
+			role.CompanyName = g.CompanyName
+		}
+		if role.Location == "" {
+			role.Location = g.Location
+		}
+		if !role.Remote {
+			role.Remote = g.Remote
+		}
+		if role.EmploymentType == "" {
+			role.EmploymentType = g.EmploymentType
+		}
+		roles[i] = role
+	}
+	return roles
+}
+
+// DecodeWorkExperiences decodes a work experience catalog into its roles
+// Entries are either a single role or a WorkExperienceGroup listing its roles under "roles"
+func DecodeWorkExperiences(data []byte) ([]WorkExperience, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	var experiences []WorkExperience
+	for i, entry := range entries {
+		var group WorkExperienceGroup
+		if err := json.Unmarshal(entry, &group); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		if group.Roles != nil {
+			experiences = append(experiences, group.Flatten()...)
+			continue
+		}
+
+		var experience WorkExperience
+		if err := json.Unmarshal(entry, &experience); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		experiences = append(experiences, experience)
+	}
+	return experiences, nil
+}
+
+// Employer holds the consecutive roles held at the same company, most recent first
+type Employer struct {
+	CompanyName string
+	Roles       []WorkExperience
+}
+
+// GroupByCompany groups consecutive roles at the same company, ignoring case
+// Roles at a company left and joined again later are kept in separate groups
+func GroupByCompany(experiences []WorkExperience) []Employer {
+	var employers []Employer
+	for _, experience := range experiences {
+		last := len(employers) - 1
+		if last >= 0 && strings.EqualFold(strings.TrimSpace(employers[last].CompanyName), strings.TrimSpace(experience.CompanyName)) {
+			employers[last].Roles = append(employers[last].Roles, experience)
+			continue
+		}
+		employers = append(employers, Employer{CompanyName: experience.CompanyName, Roles: []WorkExperience{experience}})
+	}
+	return employers
+}
+
+// StartDate returns the start of the earliest role
+func (e Employer) StartDate() Date {
+	var start Date
+	for _, role := range e.Roles {
+		if start.IsOpen() || (!role.StartDate.IsOpen() && role.StartDate.Before(start.Time)) {
+			start = role.StartDate
+		}
+	}
+	return start
+}
+
+// EndDate returns the end of the latest role, open while one of the roles is current
+func (e Employer) EndDate() Date {
+	var end Date
+	for _, role := range e.Roles {
+		if role.IsCurrent() {
+			return Date{}
+		}
+		if role.EndDate.After(end.Time) {
+			end = role.EndDate
+		}
+	}
+	return end
+}
+
+// IsCurrent reports whether one of the roles is still ongoing
+func (e Employer) IsCurrent() bool {
+	return e.EndDate().IsOpen()
+}
+
+// Tenure returns the time spent at the company, counting the months where roles overlap once
+func (e Employer) Tenure() Tenure {
+	return tenureOf(e.Roles, time.Now())
+}
+
+// Workplace describes where the latest role was held
+func (e Employer) Workplace() string {
+	if len(e.Roles) == 0 {
+		return ""
+	}
+	return e.Roles[0].Workplace()
+}
+
+// tenureOf adds up the calendar months covered by the roles, measuring current roles up to now
+func tenureOf(roles []WorkExperience, now time.Time) Tenure {
+	type period struct{ start, end int }
+	monthIndex := func(t time.Time) int { return t.Year()*12 + int(t.Month()) - 1 }
+
+	var periods []period
+	for _, role := range roles {
+		if role.StartDate.IsOpen() {
+			continue
+		}
+		periods = append(periods, period{monthIndex(role.StartDate.Time), monthIndex(role.EndDate.OrNow(now))})
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start < periods[j].start })
+
+	months, covered := 0, -1
+	for _, p := range periods {
+		if p.start <= covered {
+			p.start = covered + 1
+		}
+		if p.end >= p.start {
+			months += p.end - p.start + 1
+			covered = p.end
+		}
+	}
+	return TenureFromMonths(months)
 }
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecodeWorkExperiences(t *testing.T) {
+	data := []byte(`[
+		{"companyName": "CGI", "location": "Montreal, QC", "employmentType": "contract", "roles": [
+			{"jobTitle": "Lead", "startDate": "2024-01", "endDate": "Current", "achievements": ["Mentored 3 developers", {"text": "Cut build times", "metric": "40% faster"}]},
+			{"jobTitle": "Developer", "startDate": "2022-01", "endDate": "2024-01", "employmentType": "full-time", "remote": true}
+		]},
+		{"jobTitle": "Intern", "companyName": "Acme", "startDate": "2021-05", "endDate": "2021-08"}
+	]`)
+
+	experiences, err := DecodeWorkExperiences(data)
+	if err != nil {
+		t.Fatalf("DecodeWorkExperiences() error = %v", err)
+	}
+	if len(experiences) != 3 {
+		t.Fatalf("DecodeWorkExperiences() = %d roles, want 3", len(experiences))
+	}
+
+	lead, developer, intern := experiences[0], experiences[1], experiences[2]
+	if lead.CompanyName != "CGI" || lead.Location != "Montreal, QC" || lead.EmploymentType != EmploymentContract {
+		t.Errorf("lead = %+v, want the company details copied", lead)
+	}
+	if developer.EmploymentType != EmploymentFullTime || developer.Workplace() != "Montreal, QC · Remote" {
+		t.Errorf("developer = %+v, want its own employment type and remote flag kept", developer)
+	}
+	if len(lead.Achievements) != 2 || lead.Achievements[0].Text != "Mentored 3 developers" || lead.Achievements[1].String() != "Cut build times (40% faster)" {
+		t.Errorf("lead achievements = %+v", lead.Achievements)
+	}
+	if intern.JobTitle != "Intern" || intern.CompanyName != "Acme" {
+		t.Errorf("flat entry = %+v", intern)
+	}
+}
+
+func TestGroupByCompany(t *testing.T) {
+	experiences := []WorkExperience{
+		{JobTitle: "Lead", CompanyName: "CGI", StartDate: MustParseDate("2024-01")},
+		{JobTitle: "Developer", CompanyName: "cgi ", StartDate: MustParseDate("2022-01"), EndDate: MustParseDate("2024-03")},
+		{JobTitle: "Intern", CompanyName: "Acme", StartDate: MustParseDate("2021-05"), EndDate: MustParseDate("2021-08")},
+		{JobTitle: "Student", CompanyName: "CGI", StartDate: MustParseDate("2020-05"), EndDate: MustParseDate("2020-08")},
+	}
+
+	employers := GroupByCompany(experiences)
+	if len(employers) != 3 || len(employers[0].Roles) != 2 {
+		t.Fatalf("GroupByCompany() = %+v, want the first two roles grouped and CGI repeated after Acme", employers)
+	}
+
+	cgi := employers[0]
+	if !cgi.IsCurrent() || cgi.StartDate().String() != "2022-01" {
+		t.Errorf("employer = %s to %s, want 2022-01 to present", cgi.StartDate(), cgi.EndDate())
+	}
+
+	// January 2022 to June 2025 with the overlapping months of 2024 counted once
+	now := time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC)
+	if tenure := tenureOf(cgi.Roles, now); tenure != (Tenure{Years: 3, Months: 6}) {
+		t.Errorf("tenure = %+v, want 3 years 6 months", tenure)
+	}
+}
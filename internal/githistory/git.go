@@ -0,0 +1,139 @@
+package githistory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// Fields of a commit in the git log output, separated by \x1f and preceded by \x1e
+const logFormat = "%x1e%H%x1f%an%x1f%aI%x1f%s"
+
+// Catalog is a JSON catalog whose entries get a history of their own
+type Catalog struct {
+	Path string
+	// Key identifies an entry across versions of the catalog, false for entries to leave out
+	Key func(entry json.RawMessage) (string, bool)
+}
+
+// Load reads the history of files and catalogs from the git repository at root, without any network access
+// Files may be directories, in which case every file below them gets a history
+// Requires the git command and a .git directory at root; shallow clones only yield the commits they hold
+func Load(ctx context.Context, root string, files []string, catalogs []Catalog) (*History, error) {
+	if _, err := os.Stat(filepath.Join(root, ".git")); err != nil {
+		return nil, fmt.Errorf("no git repository at %s: %w", root, err)
+	}
+
+	h := &History{Entries: map[string]map[string]models.Revisions{}, root: root}
+	args := []string{"log", "--relative", "--no-renames", "--name-only", "--format=" + logFormat, "--"}
+	for _, file := range files {
+		args = append(args, h.relative(file))
+	}
+	for _, catalog := range catalogs {
+		args = append(args, h.relative(catalog.Path))
+	}
+
+	output, err := git(ctx, root, args...)
+	if err != nil {
+		return nil, err
+	}
+	h.Files = parseLog(output)
+
+	for _, catalog := range catalogs {
+		path := h.relative(catalog.Path)
+		entries, err := entryRevisions(ctx, root, path, h.Files[path], catalog.Key)
+		if err != nil {
+			return nil, err
+		}
+		h.Entries[path] = entries
+	}
+	return h, nil
+}
+
+// parseLog groups the commits of a git log listing by the files they changed, newest first
+func parseLog(output []byte) map[string]models.Revisions {
+	files := map[string]models.Revisions{}
+	for _, record := range strings.Split(string(output), "\x1e") {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.Split(lines[0], "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		committed, err := time.Parse(time.RFC3339, fields[2])
+		if err != nil {
+			continue
+		}
+
+		revision := models.Revision{Hash: fields[0], Author: fields[1], Time: committed, Subject: fields[3]}
+		for _, file := range lines[1:] {
+			if file = strings.TrimSpace(file); file != "" {
+				files[file] = append(files[file], revision)
+			}
+		}
+	}
+	return files
+}
+
+// entryRevisions replays the versions of a catalog, oldest first, and keeps for every entry
+// the commits that added or changed it, newest first
+// Formatting changes are ignored, versions that are not valid JSON are skipped
+func entryRevisions(ctx context.Context, root string, path string, revisions models.Revisions, key func(json.RawMessage) (string, bool)) (map[string]models.Revisions, error) {
+	entries := map[string]models.Revisions{}
+	previous := map[string]string{}
+	for _, revision := range slices.Backward(revisions) {
+		content, err := git(ctx, root, "show", revision.Hash+":./"+path)
+		if err != nil {
+			// Removed by this commit
+			previous = map[string]string{}
+			continue
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(content, &items); err != nil {
+			continue
+		}
+
+		current := map[string]string{}
+		for _, item := range items {
+			id, ok := key(item)
+			if !ok {
+				continue
+			}
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, item); err != nil {
+				continue
+			}
+			current[id] = compact.String()
+			if previous[id] != current[id] {
+				entries[id] = append(entries[id], revision)
+			}
+		}
+		previous = current
+	}
+
+	for _, history := range entries {
+		slices.Reverse(history)
+	}
+	return entries, ctx.Err()
+}
+
+// git runs a git command in dir and returns its standard output
+func git(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
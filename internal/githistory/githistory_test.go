@@ -0,0 +1,105 @@
+package githistory
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// commit writes files into the repository at dir and commits them at the given date
+func commit(t *testing.T, dir string, date string, subject string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{{"add", "-A"}, {"commit", "-q", "-m", subject}} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Author", "GIT_AUTHOR_EMAIL=author@example.com", "GIT_AUTHOR_DATE="+date,
+			"GIT_COMMITTER_NAME=Author", "GIT_COMMITTER_EMAIL=author@example.com", "GIT_COMMITTER_DATE="+date)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, output)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	if output, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, output)
+	}
+
+	commit(t, dir, "2025-04-20T10:00:00Z", "Add first post", map[string]string{
+		"markdown/first.md": "# First",
+		"blogs.json":        `[{"id": "first", "title": "First"}]`,
+		"other.txt":         "not content",
+	})
+	commit(t, dir, "2025-05-04T10:00:00Z", "Add second post", map[string]string{
+		"markdown/second.md": "# Second",
+		"blogs.json":         `[{"id": "first", "title": "First"}, {"id": "second", "title": "Second"}]`,
+	})
+	commit(t, dir, "2025-06-01T10:00:00Z", "Fix a typo and reformat the catalog", map[string]string{
+		"markdown/first.md": "# First post",
+		"blogs.json":        "[\n  {\"id\": \"first\", \"title\": \"First\"},\n  {\"id\": \"second\", \"title\": \"Second\"}\n]",
+	})
+
+	catalog := Catalog{Path: filepath.Join(dir, "blogs.json"), Key: func(entry json.RawMessage) (string, bool) {
+		var blog struct {
+			Id string `json:"id"`
+		}
+		return blog.Id, json.Unmarshal(entry, &blog) == nil
+	}}
+	history, err := Load(context.Background(), dir, []string{filepath.Join(dir, "markdown")}, []Catalog{catalog})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	first := history.File(filepath.Join(dir, "markdown", "first.md"))
+	if len(first) != 2 || first[0].Subject != "Fix a typo and reformat the catalog" || first[1].Subject != "Add first post" {
+		t.Errorf("File(first.md) = %v, want the typo fix then the first commit", first)
+	}
+	if created := first.Created().Format("2006-01-02"); created != "2025-04-20" {
+		t.Errorf("Created() = %s, want 2025-04-20", created)
+	}
+	if history.File("other.txt") != nil {
+		t.Error("File(other.txt) has revisions for a file outside the content paths")
+	}
+
+	// The reformatting commit changes no entry and the second commit only adds "second"
+	if entry := history.Entry(catalog.Path, "first"); len(entry) != 1 || entry[0].Subject != "Add first post" {
+		t.Errorf("Entry(first) = %v, want only the commit adding it", entry)
+	}
+	if entry := history.Entry("blogs.json", "second"); len(entry) != 1 || entry[0].Subject != "Add second post" {
+		t.Errorf("Entry(second) = %v, want only the commit adding it", entry)
+	}
+
+	path := filepath.Join(t.TempDir(), "revisions.json")
+	if err := history.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	saved, err := Open(path, dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if got := saved.File(filepath.Join(dir, "markdown", "second.md")); len(got) != 1 || got[0].Hash != history.File("markdown/second.md")[0].Hash {
+		t.Errorf("saved File(second.md) = %v, want the revision read from git", got)
+	}
+}
+
+func TestLoadWithoutRepository(t *testing.T) {
+	if _, err := Load(context.Background(), t.TempDir(), nil, nil); err == nil {
+		t.Error("Load() error = nil, want an error without a .git directory")
+	}
+}
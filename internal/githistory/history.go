@@ -0,0 +1,70 @@
+// Package githistory derives the revision history of the content files and catalog entries from the local git repository
+package githistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// History holds the revisions of the content files and catalog entries, newest first
+// Paths are slash separated and relative to the project root so a saved history can be read from another checkout
+type History struct {
+	Files   map[string]models.Revisions            `json:"files"`
+	Entries map[string]map[string]models.Revisions `json:"entries"` // by catalog path, then entry key
+	root    string
+}
+
+// Open reads a history saved by Save, resolving paths against root
+func Open(path string, root string) (*History, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &History{root: root}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("invalid revision history %s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Save writes the history to path, replacing the previous file atomically
+func (h *History) Save(path string) error {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// File returns the revisions of a file, given as an absolute path or relative to the project root
+func (h *History) File(path string) models.Revisions {
+	return h.Files[h.relative(path)]
+}
+
+// Entry returns the revisions of the entry of a catalog with the given key
+func (h *History) Entry(catalog string, key string) models.Revisions {
+	return h.Entries[h.relative(catalog)][key]
+}
+
+// relative converts a path to the slash separated form the history is keyed by
+func (h *History) relative(path string) string {
+	if filepath.IsAbs(path) && h.root != "" {
+		if rel, err := filepath.Rel(h.root, path); err == nil {
+			path = rel
+		}
+	}
+	return filepath.ToSlash(filepath.Clean(path))
+}
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/cache"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// APIPrefix is the path every versioned API route lives under
+const APIPrefix = "/api/v1"
+
+// maxAPIPageSize caps the ?pageSize= accepted by the API
+const maxAPIPageSize = 100
+
+// APIList is a page of items returned by the API list endpoints
+type APIList[T any] struct {
+	Items     []T    `json:"items"`
+	Page      int    `json:"page"`
+	PageSize  int    `json:"pageSize"`
+	Total     int    `json:"total"`
+	PageCount int    `json:"pageCount"`
+	Next      string `json:"next,omitempty"`
+	Previous  string `json:"previous,omitempty"`
+}
+
+// APIBlog is a blog along with its rendered HTML body
+type APIBlog struct {
+	models.Blog
+	HTML      string           `json:"html"`
+	Revisions models.Revisions `json:"revisions,omitempty"`
+}
+
+// APITableOfContents is the pre-generated table of contents of a blog
+type APITableOfContents struct {
+	Id   string `json:"id"`
+	HTML string `json:"html"`
+}
+
+// apiError is the body of every API error response
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// NewAPIHandler returns the router serving the read-only JSON API
+func NewAPIHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+APIPrefix+"/blogs", ServeAPIBlogs)
+	mux.HandleFunc("GET "+APIPrefix+"/blogs/{id}", ServeAPIBlog)
+	mux.HandleFunc("GET "+APIPrefix+"/blogs/{id}/toc", ServeAPIBlogTableOfContents)
+	mux.HandleFunc("GET "+APIPrefix+"/projects", ServeAPIProjects)
+	mux.HandleFunc("GET "+APIPrefix+"/work-experience", ServeAPIWorkExperience)
+	mux.HandleFunc("GET "+APIPrefix+"/certifications", ServeAPICertifications)
+	mux.HandleFunc("GET "+APIPrefix+"/favorites", ServeAPIFavorites)
+	return mux
+}
+
+// ServeAPIBlogs lists blogs, accepting the same ?tag=, ?q= and ?sort= options as the blog page
+func ServeAPIBlogs(w http.ResponseWriter, r *http.Request) {
+	params := parseListingParams(r, blogSorts...)
+	serveAPIList(w, r, blogListQuery(params), params, blogSorts[0])
+}
+
+// ServeAPIBlog returns a blog with its HTML body
+func ServeAPIBlog(w http.ResponseWriter, r *http.Request) {
+	blog, err := parser.GetBlogByID(r.PathValue("id"))
+	if err != nil {
+		writeAPILookupError(w, r, "blog", err)
+		return
+	}
+
+	html, err := parser.GetBlogHTMLContent(blog.Id)
+	if err != nil {
+		writeAPILookupError(w, r, "blog content", err)
+		return
+	}
+
+	blog = parser.WithWordCounts([]models.Blog{blog})[0]
+	revisions := parser.GetBlogRevisions(blog.Id)
+	setLastModified(w, revisions)
+	writeJSON(w, r, http.StatusOK, APIBlog{Blog: blog, HTML: html, Revisions: revisions})
+}
+
+// ServeAPIBlogTableOfContents returns the table of contents of a blog
+func ServeAPIBlogTableOfContents(w http.ResponseWriter, r *http.Request) {
+	blog, err := parser.GetBlogByID(r.PathValue("id"))
+	if err != nil {
+		writeAPILookupError(w, r, "blog", err)
+		return
+	}
+
+	toc, err := parser.GetBlogTableOfContents(blog.Id)
+	if err != nil {
+		writeAPILookupError(w, r, "table of contents", err)
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, APITableOfContents{Id: blog.Id, HTML: toc})
+}
+
+// ServeAPIProjects lists projects, accepting the same ?tag=, ?q= and ?sort= options as the projects page
+func ServeAPIProjects(w http.ResponseWriter, r *http.Request) {
+	params := parseListingParams(r, projectSorts...)
+	serveAPIList(w, r, projectListQuery(params), params, projectSorts[0])
+}
+
+// ServeAPIWorkExperience lists work experience, newest first
+func ServeAPIWorkExperience(w http.ResponseWriter, r *http.Request) {
+	serveAPIList(w, r, parser.QueryWorkExperiences(), parseListingParams(r), "")
+}
+
+// ServeAPICertifications lists certifications, newest first
+func ServeAPICertifications(w http.ResponseWriter, r *http.Request) {
+	serveAPIList(w, r, parser.QueryCertifications(), parseListingParams(r), "")
+}
+
+// ServeAPIFavorites lists the reading list, newest first
+func ServeAPIFavorites(w http.ResponseWriter, r *http.Request) {
+	serveAPIList(w, r, parser.QueryFavorites(), parseListingParams(r), "")
+}
+
+// serveAPIList runs a query for the requested page and writes it as an APIList
+func serveAPIList[T any](w http.ResponseWriter, r *http.Request, query *cache.Query[T], params ListingParams, defaultSort string) {
+	size := apiPageSize(r)
+
+	result, err := query.Page(params.Page, size).Run()
+	if err != nil {
+		logger.LogError("Error loading " + r.URL.Path + ": " + err.Error())
+		writeJSON(w, r, http.StatusInternalServerError, apiError{Error: "Error loading content"})
+		return
+	}
+
+	list := APIList[T]{
+		Items:     result.Items,
+		Page:      result.Page(),
+		PageSize:  size,
+		Total:     result.Total,
+		PageCount: result.PageCount(),
+	}
+	if result.HasNext() {
+		list.Next = apiPageURL(r.URL.Path, params, result.NextPage(), size, defaultSort)
+	}
+	if result.HasPrevious() {
+		list.Previous = apiPageURL(r.URL.Path, params, result.PreviousPage(), size, defaultSort)
+	}
+
+	writeJSON(w, r, http.StatusOK, list)
+}
+
+// apiPageSize reads ?pageSize=, falling back to the site page size and capping it at maxAPIPageSize
+func apiPageSize(r *http.Request) int {
+	size, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil || size <= 0 {
+		return pageSize()
+	}
+	return min(size, maxAPIPageSize)
+}
+
+// apiPageURL builds the URL of another page of an API list, keeping the page size when it was customized
+func apiPageURL(path string, params ListingParams, page int, size int, defaultSort string) string {
+	link := params.URL(path, page, defaultSort)
+	if size == pageSize() {
+		return link
+	}
+	separator := "?"
+	if strings.Contains(link, "?") {
+		separator = "&"
+	}
+	return link + separator + "pageSize=" + strconv.Itoa(size)
+}
+
+// writeAPILookupError answers with 404 when the content does not exist and 500 otherwise
+func writeAPILookupError(w http.ResponseWriter, r *http.Request, what string, err error) {
+	if errors.Is(err, os.ErrNotExist) {
+		writeJSON(w, r, http.StatusNotFound, apiError{Error: what + " not found"})
+		return
+	}
+	logger.LogError("Error loading " + what + ": " + err.Error())
+	writeJSON(w, r, http.StatusInternalServerError, apiError{Error: "Error loading " + what})
+}
+
+// writeJSON encodes v and writes it with an ETag derived from the body
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	// HTML bodies are returned as is instead of having <, > and & escaped
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		logger.LogError("Error encoding JSON response: " + err.Error())
+		http.Error(w, "Error encoding response", http.StatusInternalServerError)
+		return
+	}
+
+	writeWithETag(w, r, status, "application/json; charset=utf-8", buffer.Bytes())
+}
+
+// writeWithETag writes a response body along with an ETag derived from it
+// Successful responses matching the request's If-None-Match are answered with 304 Not Modified
+func writeWithETag(w http.ResponseWriter, r *http.Request, status int, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if status == http.StatusOK && etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// setLastModified sets the Last-Modified header to the time of the newest revision of the content
+// Only used ahead of writeWithETag, whose ETag and no-cache directive answer conditional requests,
+// as HTML pages carry no validators and would otherwise be cached heuristically from this date
+func setLastModified(w http.ResponseWriter, revisions models.Revisions) {
+	if len(revisions) > 0 {
+		w.Header().Set("Last-Modified", revisions.Modified().UTC().Format(http.TimeFormat))
+	}
+}
+
+// etagMatches reports whether an If-None-Match header matches the ETag, using weak comparison
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
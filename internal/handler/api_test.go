@@ -0,0 +1,126 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/repository"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func setupAPIRepository(t *testing.T) {
+	t.Helper()
+	repo := repository.NewMemoryRepository()
+	repo.AddBlog(models.Blog{Id: "first", Title: "First", Tags: []string{"Go"}, PublishedDate: models.MustParseDate("2024-01-01")}, "<p>first</p>", "<ul></ul>")
+	repo.AddBlog(models.Blog{Id: "second", Title: "Second", Tags: []string{"HTMX"}, PublishedDate: models.MustParseDate("2025-01-01")}, "<p>second</p>", "<ul></ul>")
+	repo.AddBlog(models.Blog{Id: "third", Title: "Third", Tags: []string{"Go"}, PublishedDate: models.MustParseDate("2025-06-01")}, "<p>third</p>", "<ul></ul>")
+	parser.SetRepository(repo)
+	t.Cleanup(func() { parser.SetRepository(nil) })
+}
+
+func TestAPIBlogs(t *testing.T) {
+	setupAPIRepository(t)
+	api := NewAPIHandler()
+
+	tests := []struct {
+		name          string
+		url           string
+		expectedIds   []string
+		expectedTotal int
+		expectedNext  string
+	}{
+		{
+			name:          "Newest first",
+			url:           "/api/v1/blogs",
+			expectedIds:   []string{"third", "second", "first"},
+			expectedTotal: 3,
+		},
+		{
+			name:          "Filtered by tag",
+			url:           "/api/v1/blogs?tag=go",
+			expectedIds:   []string{"third", "first"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Custom page size",
+			url:           "/api/v1/blogs?pageSize=2&sort=oldest",
+			expectedIds:   []string{"first", "second"},
+			expectedTotal: 3,
+			expectedNext:  "/api/v1/blogs?page=2&sort=oldest&pageSize=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			api.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+			}
+
+			var list APIList[models.Blog]
+			if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+
+			var ids []string
+			for _, blog := range list.Items {
+				ids = append(ids, blog.Id)
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIds) {
+				t.Errorf("ids = %v, want %v", ids, tt.expectedIds)
+			}
+			if list.Total != tt.expectedTotal {
+				t.Errorf("total = %d, want %d", list.Total, tt.expectedTotal)
+			}
+			if list.Next != tt.expectedNext {
+				t.Errorf("next = %q, want %q", list.Next, tt.expectedNext)
+			}
+		})
+	}
+}
+
+func TestAPIBlogETag(t *testing.T) {
+	setupAPIRepository(t)
+	api := NewAPIHandler()
+
+	w := httptest.NewRecorder()
+	api.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/blogs/second", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var blog APIBlog
+	if err := json.Unmarshal(w.Body.Bytes(), &blog); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if blog.HTML != "<p>second</p>" {
+		t.Errorf("html = %q, want %q", blog.HTML, "<p>second</p>")
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag header")
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/blogs/second", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	api.ServeHTTP(w, r)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("status with matching If-None-Match = %d, want %d", w.Code, http.StatusNotModified)
+	}
+}
+
+func TestAPIBlogNotFound(t *testing.T) {
+	setupAPIRepository(t)
+
+	w := httptest.NewRecorder()
+	NewAPIHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/blogs/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
@@ -1,26 +1,97 @@
 package handler
 
 import (
+	"aHobeychi/personal-website/internal/cache"
+	models "aHobeychi/personal-website/internal/domain"
 	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
 	"html/template"
 	"net/http"
 	"path/filepath"
 	"strings"
 )
 
+// Sort orders accepted by the blog list, the first one is the default
+var blogSorts = []string{"newest", "oldest", "title"}
+
+// blogListQuery builds the blog query matching the listing options
+// The listed blogs carry their word counts for the reading times
+func blogListQuery(params ListingParams) *cache.Query[models.Blog] {
+	query := parser.QueryBlogsWithWordCounts()
+	if params.Tag != "" {
+		query.Where(func(b models.Blog) bool { return b.HasTag(params.Tag) })
+	}
+	if params.Query != "" {
+		query.Where(func(b models.Blog) bool { return b.Matches(params.Query) })
+	}
+	switch params.Sort {
+	case "oldest":
+		query.SortBy(models.CompareBlogsOldestFirst)
+	case "title":
+		query.SortBy(models.CompareBlogsByTitle)
+	}
+	return query
+}
+
 // ServeBlogList handles the blog list page
+// Supports ?page=, ?tag=, ?sort= and ?q=, and returns only the next items for HTMX "load more" requests
+// Also served as JSON or Markdown, see negotiateFormat
 func ServeBlogList(w http.ResponseWriter, r *http.Request) {
-	// The ServeMux ensures this handler is only called for the exact path "/blog"
+	// The ServeMux ensures this handler is only called for "/blog" and its ".json" and ".md" variants
 	// so we don't need to check r.URL.Path here
+	format := negotiateFormat(w, r)
+	params := parseListingParams(r, blogSorts...)
+	query := blogListQuery(params)
+
+	if format == FormatJSON {
+		serveAPIList(w, r, query, params, blogSorts[0])
+		return
+	}
 
-	blogs, err := parser.ParseBlogs()
+	blogs, err := query.Page(params.Page, pageSize()).Run()
 	if err != nil {
 		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
 		return
 	}
 
+	// Pages after the last one would only be empty listings, keep crawlers from indexing them
+	if blogs.IsPastEnd() {
+		http.Error(w, "Page not found", http.StatusNotFound)
+		return
+	}
+
+	if format == FormatMarkdown {
+		writeMarkdown(w, r, blogListMarkdown(blogs, params))
+		return
+	}
+
+	allBlogs, err := parser.ParseBlogs()
+	if err != nil {
+		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
+		return
+	}
+	var tags [][]string
+	for _, blog := range allBlogs {
+		tags = append(tags, blog.Tags)
+	}
+
 	data := PageData{
-		"blogs": blogs,
+		"Meta":        PageMeta{Title: "Notes", Description: "Notes on what I've been building and learning", Path: params.URL("/blog", blogs.Page(), blogSorts[0]), StructuredData: []JSONLD{blogJSONLD(blogs.Items)}},
+		"blogs":       blogs.Items,
+		"BlogResults": blogs,
+		"Tags":        models.DistinctTags(tags...),
+		"BlogArchive": models.BuildBlogArchive(allBlogs),
+	}
+	addPaginationData(data, "/blog", params, blogSorts[0], blogs)
+
+	// Lets the page announce the number of matching notes after filtering
+	if isHTMXRequest(r) {
+		HTMX(w).Trigger("listing-updated", map[string]int{"total": blogs.Total})
+	}
+
+	if isLoadMoreRequest(r, params) {
+		RenderTemplate(w, r, "blog-list-items", data)
+		return
 	}
 
 	// RenderTemplate already checks for HTMX headers and renders appropriately
@@ -28,16 +99,30 @@ func ServeBlogList(w http.ResponseWriter, r *http.Request) {
 }
 
 // ServeBlogContent handles rendering a specific blog post
+// Also served as JSON or as its original Markdown source, see negotiateFormat
 func ServeBlogContent(w http.ResponseWriter, r *http.Request) {
+	format := negotiateFormat(w, r)
 
 	// Extract blog ID from URL path using the existing helper function
-	id := extractBlogIDFromPath(r.URL.Path)
+	id := extractBlogIDFromPath(trimFormatSuffix(r.URL.Path))
 
 	blog, err := parser.GetBlogByID(id)
 	if err != nil {
 		http.Error(w, "Blog not found", http.StatusNotFound)
 		return
 	}
+	revisions := parser.GetBlogRevisions(blog.Id)
+
+	if format == FormatMarkdown {
+		markdown, err := parser.GetBlogMarkdown(blog.Id)
+		if err != nil {
+			http.Error(w, "Blog source not found", http.StatusNotFound)
+			return
+		}
+		setLastModified(w, revisions)
+		writeMarkdown(w, r, markdown)
+		return
+	}
 
 	contentData, err := parser.GetBlogHTMLContent(blog.Id)
 	if err != nil {
@@ -45,6 +130,20 @@ func ServeBlogContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Word counts, neighbours and related posts are precomputed, a missing index only hides them
+	index, err := parser.GetBlogIndex()
+	if err != nil {
+		logger.LogWarning("Error loading the blog index: " + err.Error())
+	} else {
+		blog.WordCount = index.WordCount(blog.Id)
+	}
+
+	if format == FormatJSON {
+		setLastModified(w, revisions)
+		writeJSON(w, r, http.StatusOK, APIBlog{Blog: blog, HTML: contentData, Revisions: revisions})
+		return
+	}
+
 	// Determine the source page by checking the Referer header
 	sourcePage := "notes" // Default to "notes"
 	referer := GetRefererPage(r)
@@ -55,10 +154,23 @@ func ServeBlogContent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := PageData{
+		"Meta":        BlogMeta(blog),
+		"Blog":        blog,
 		"BlogTitle":   blog.Title,
 		"BlogID":      blog.Id,
 		"ContentData": template.HTML(contentData), // Convert to template.HTML to prevent escaping
 		"SourcePage":  sourcePage,                 // Add source page information
+		"Revisions":   revisions,
+	}
+
+	if index != nil {
+		if previous, ok := index.Previous(blog.Id); ok {
+			data["PreviousBlog"] = previous
+		}
+		if next, ok := index.Next(blog.Id); ok {
+			data["NextBlog"] = next
+		}
+		data["RelatedBlogs"] = index.Related(blog.Id)
 	}
 
 	// RenderTemplate already checks for HTMX headers and renders appropriately
@@ -99,6 +211,29 @@ func ServeBlogTableOfContents(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(tocContent))
 }
 
+// ServeBlogOGImage serves the generated Open Graph preview image of a blog post
+func ServeBlogOGImage(w http.ResponseWriter, r *http.Request) {
+	// The path looks like "/blog/{id}/og.png"
+	blogID := filepath.Base(filepath.Dir(r.URL.Path))
+
+	blog, err := parser.GetBlogByID(blogID)
+	if err != nil {
+		http.Error(w, "Blog not found", http.StatusNotFound)
+		return
+	}
+
+	imagePath, err := parser.GetBlogOGImagePath(blog.Id)
+	if err != nil {
+		logger.LogError("Error generating preview image for blog " + blog.Id + ": " + err.Error())
+		http.Error(w, "Failed to load preview image", http.StatusInternalServerError)
+		return
+	}
+
+	// The URL is stable, so previews may be cached for a day before being refreshed
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	http.ServeFile(w, r, imagePath)
+}
+
 // ExtractBlogIDFromPath extracts the blog ID from the URL path
 func extractBlogIDFromPath(path string) string {
 	// Extracts the last segment from a URL path like "/blog/my-blog-post"
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// IsBlogArchivePath reports whether a /blog/ path is one of the archive pages rather than a blog post
+func IsBlogArchivePath(path string) bool {
+	segments := blogPathSegments(path)
+	return len(segments) > 0 && models.IsArchiveSegment(segments[0])
+}
+
+// blogPathSegments splits a /blog/ path after the prefix, without its format suffix
+func blogPathSegments(path string) []string {
+	trimmed := strings.Trim(strings.TrimPrefix(trimFormatSuffix(path), "/blog/"), "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
+
+// blogArchive groups every blog by year and month of publication
+func blogArchive() ([]models.ArchiveYear, error) {
+	blogs, err := parser.ParseBlogs()
+	if err != nil {
+		return nil, err
+	}
+	return models.BuildBlogArchive(blogs), nil
+}
+
+// ServeBlogArchive handles /blog/archive, listing the number of posts of every year and month,
+// and the posts of a period at /blog/{year} and /blog/{year}/{month}
+// Also served as JSON, see negotiateFormat
+func ServeBlogArchive(w http.ResponseWriter, r *http.Request) {
+	format := negotiateFormat(w, r)
+
+	archive, err := blogArchive()
+	if err != nil {
+		logger.LogError("Error parsing blogs: " + err.Error())
+		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
+		return
+	}
+
+	segments := blogPathSegments(r.URL.Path)
+	if len(segments) == 1 && segments[0] == models.ArchiveSegment {
+		if format == FormatJSON {
+			writeJSON(w, r, http.StatusOK, archive)
+			return
+		}
+
+		data := PageData{
+			"Meta":        PageMeta{Title: "Archive", Description: "Every note by year and month", Path: "/blog/" + models.ArchiveSegment},
+			"Archive":     archive,
+			"BlogArchive": archive,
+		}
+		RenderTemplate(w, r, "blog-archive", data)
+		return
+	}
+
+	year, month, ok := models.ParseArchivePeriod(segments)
+	if !ok {
+		http.Error(w, "Blog not found", http.StatusNotFound)
+		return
+	}
+
+	var blogs []models.Blog
+	var title, path string
+	if month == 0 {
+		y, found := models.FindArchiveYear(archive, year)
+		if !found {
+			http.Error(w, "No notes published in this period", http.StatusNotFound)
+			return
+		}
+		blogs = y.Blogs()
+		title = fmt.Sprintf("%d", year)
+		path = fmt.Sprintf("/blog/%d", year)
+	} else {
+		m, found := models.FindArchiveMonth(archive, year, month)
+		if !found {
+			http.Error(w, "No notes published in this period", http.StatusNotFound)
+			return
+		}
+		blogs = m.Blogs
+		title = formatDate(m.Date())
+		path = fmt.Sprintf("/blog/%d/%02d", year, int(month))
+	}
+
+	blogs = parser.WithWordCounts(blogs)
+
+	if format == FormatJSON {
+		writeJSON(w, r, http.StatusOK, blogs)
+		return
+	}
+
+	data := PageData{
+		"Meta":        PageMeta{Title: "Notes from " + title, Description: fmt.Sprintf("%s published in %s", plural(len(blogs), "note", "notes"), title), Path: path},
+		"PeriodTitle": title,
+		"PeriodYear":  year,
+		"PeriodMonth": int(month),
+		"blogs":       blogs,
+		"BlogArchive": archive,
+	}
+	RenderTemplate(w, r, "blog-archive-period", data)
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Warning window used when resume.certifications.expiringSoonDays is not set
+const defaultExpiringSoonDays = 90
+
+// certificationOrder returns how certifications are listed, "date" or "issuer"
+// The ?certifications= query parameter overrides resume.certifications.order
+func certificationOrder(r *http.Request) string {
+	order := r.URL.Query().Get("certifications")
+	if order != "date" && order != "issuer" {
+		order = config.Get().Resume.Certifications.Order
+	}
+	if order != "issuer" {
+		order = "date"
+	}
+	return order
+}
+
+// includeExpired reports whether a resume export asked for expired certifications with ?expired=true
+func includeExpired(r *http.Request) bool {
+	include, _ := strconv.ParseBool(r.URL.Query().Get("expired"))
+	return include
+}
+
+// orderCertifications sorts certifications by date or by issuer, see certificationOrder
+func orderCertifications(certifications []models.Certification, order string) []models.Certification {
+	sorted := slices.Clone(certifications)
+	if order == "issuer" {
+		slices.SortStableFunc(sorted, models.CompareCertificationsByIssuer)
+	} else {
+		slices.SortStableFunc(sorted, models.CompareCertifications)
+	}
+	return sorted
+}
+
+// certificationWarning returns how long before expiry a certification is shown as expiring soon
+func certificationWarning() time.Duration {
+	days := config.Get().Resume.Certifications.ExpiringSoonDays
+	if days <= 0 {
+		days = defaultExpiringSoonDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// certificationStatus returns whether a certification is active, expiring soon or expired today
+func certificationStatus(certification models.Certification) string {
+	return certification.Status(time.Now(), certificationWarning())
+}
+
+// issuerLogo returns the URL of the issuer logo set on a certification, or "" when there is none
+func issuerLogo(certification models.Certification) string {
+	if certification.Logo == "" {
+		return ""
+	}
+	return "/static/" + strings.TrimPrefix(certification.Logo, "/")
+}
+
+// certificationDetails describes the credential ID and expiry of a certification, e.g. "Credential ID ABC-123 · Expires March 2027"
+func certificationDetails(certification models.Certification) string {
+	var parts []string
+	if certification.CredentialID != "" {
+		parts = append(parts, "Credential ID "+certification.CredentialID)
+	}
+	if certification.Expires() {
+		if certification.IsExpired(time.Now()) {
+			parts = append(parts, "Expired "+formatDate(certification.ExpiryDate))
+		} else {
+			parts = append(parts, "Expires "+formatDate(certification.ExpiryDate))
+		}
+	}
+	return strings.Join(parts, " · ")
+}
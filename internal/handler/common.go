@@ -17,28 +17,43 @@ var Templates *template.Template
 // InitializeTemplates parses all HTML templates and stores them for later use
 func InitializeTemplates(templateFiles []string) {
 	var err error
-	Templates, err = template.ParseFiles(templateFiles...)
+	Templates, err = template.New("").Funcs(templateFuncs).ParseFiles(templateFiles...)
 	if err != nil {
 		panic("Error parsing templates: " + err.Error())
 	}
 }
 
 // RenderTemplate renders the appropriate template based on whether it's an HTMX request
+// Handlers describe the page through a PageMeta under the "Meta" key, HTMX partials then update
+// the document title and meta description out of band
 func RenderTemplate(w http.ResponseWriter, r *http.Request, templateName string, data PageData) {
-	// Check if this is an HTMX request
 	if data == nil {
 		data = PageData{}
 	}
+	meta, _ := data["Meta"].(PageMeta)
+	data["Head"] = meta.head(r)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	// Partials and full pages share the same URL, so caches must keep them apart
+	w.Header().Add("Vary", HTMX_HEADER)
+	w.Header().Add("Vary", HTMX_HISTORY_RESTORE_HEADER)
 
-	if r.Header.Get(HTMX_HEADER) == "true" {
-		// HTMX request - render just the partial template
+	// Check if this is an HTMX request
+	if isHTMXRequest(r) {
+		// HTMX request - render just the partial template followed by the head updates
+		// Out-of-band swaps are only rendered in partials, full pages already contain their targets
+		data["Partial"] = true
 		err := Templates.ExecuteTemplate(w, templateName, data)
+		if err == nil {
+			err = Templates.ExecuteTemplate(w, "htmx-head", data)
+		}
 		if err != nil {
 			http.Error(w, "Error rendering template: "+err.Error(), http.StatusInternalServerError)
 		}
 	} else {
 		// Regular request - render full page with index.html wrapper
 		data["Content"] = templateName
+		data["StructuredData"] = structuredData(meta)
 		err := Templates.ExecuteTemplate(w, "index.html", data)
 		if err != nil {
 			http.Error(w, "Error rendering template: "+err.Error(), http.StatusInternalServerError)
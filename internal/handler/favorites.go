@@ -0,0 +1,22 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"net/http"
+)
+
+// ServeFavorites handles the favorites page, grouping the reading list by kind
+func ServeFavorites(w http.ResponseWriter, r *http.Request) {
+	favorites, err := parser.ParseFavorites()
+	if err != nil {
+		http.Error(w, "Error loading favorites data", http.StatusInternalServerError)
+		return
+	}
+
+	data := PageData{
+		"Meta":           PageMeta{Title: "Favorites", Description: "Books, articles and tools I keep coming back to", Path: "/favorites"},
+		"FavoriteGroups": models.GroupFavoritesByKind(favorites),
+	}
+	RenderTemplate(w, r, "favorites", data)
+}
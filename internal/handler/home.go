@@ -8,10 +8,9 @@ import (
 
 // ServeHomepage handles the home page
 func ServeHomepage(w http.ResponseWriter, r *http.Request) {
-	// Get 3 projects for the home page
-
-	projects, projects_err := parser.ParseProjects(3)
-	blogs, blogs_err := parser.ParseBlogs(3)
+	// Get the first 3 projects and the 3 latest blogs for the home page
+	projects, projects_err := parser.QueryProjects().Limit(3).Run()
+	blogs, blogs_err := parser.QueryBlogs().Limit(3).Run()
 
 	if projects_err != nil {
 		logger.LogError("Error parsing projects: " + projects_err.Error())
@@ -24,8 +23,9 @@ func ServeHomepage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := PageData{
-		"projects": projects,
-		"blogs":    blogs,
+		"Meta":     PageMeta{Path: "/", StructuredData: []JSONLD{websiteJSONLD()}},
+		"projects": projects.Items,
+		"blogs":    blogs.Items,
 	}
 	RenderTemplate(w, r, "home", data)
 }
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/util/logger"
+	"encoding/json"
+	"net/http"
+)
+
+// HTMX request and response headers
+const (
+	HTMX_HISTORY_RESTORE_HEADER = "HX-History-Restore-Request"
+
+	HTMX_TRIGGER_HEADER              = "HX-Trigger"
+	HTMX_TRIGGER_AFTER_SWAP_HEADER   = "HX-Trigger-After-Swap"
+	HTMX_TRIGGER_AFTER_SETTLE_HEADER = "HX-Trigger-After-Settle"
+	HTMX_REDIRECT_HEADER             = "HX-Redirect"
+	HTMX_RETARGET_HEADER             = "HX-Retarget"
+	HTMX_RESWAP_HEADER               = "HX-Reswap"
+	HTMX_PUSH_URL_HEADER             = "HX-Push-Url"
+)
+
+// isHTMXRequest reports whether a request expects a partial rather than the whole page
+// History restores are sent by HTMX when a page is missing from its cache and need the whole page
+func isHTMXRequest(r *http.Request) bool {
+	return r.Header.Get(HTMX_HEADER) == "true" && r.Header.Get(HTMX_HISTORY_RESTORE_HEADER) != "true"
+}
+
+// HTMXResponse sets the HTMX response headers of a request
+// Headers must be set before the response body is written
+type HTMXResponse struct {
+	header   http.Header
+	triggers map[string]map[string]any
+}
+
+// HTMX returns the HTMX response headers of w
+func HTMX(w http.ResponseWriter) *HTMXResponse {
+	return &HTMXResponse{header: w.Header(), triggers: map[string]map[string]any{}}
+}
+
+// Trigger raises a client side event as soon as the response is received
+// The detail is passed to the listeners as event.detail and may be nil
+func (h *HTMXResponse) Trigger(event string, detail any) *HTMXResponse {
+	return h.trigger(HTMX_TRIGGER_HEADER, event, detail)
+}
+
+// TriggerAfterSwap raises a client side event once the new content has been swapped in
+func (h *HTMXResponse) TriggerAfterSwap(event string, detail any) *HTMXResponse {
+	return h.trigger(HTMX_TRIGGER_AFTER_SWAP_HEADER, event, detail)
+}
+
+// TriggerAfterSettle raises a client side event once the new content has settled
+func (h *HTMXResponse) TriggerAfterSettle(event string, detail any) *HTMXResponse {
+	return h.trigger(HTMX_TRIGGER_AFTER_SETTLE_HEADER, event, detail)
+}
+
+// trigger adds an event to one of the trigger headers, keeping the events raised earlier
+func (h *HTMXResponse) trigger(header string, event string, detail any) *HTMXResponse {
+	events, ok := h.triggers[header]
+	if !ok {
+		events = map[string]any{}
+		h.triggers[header] = events
+	}
+	events[event] = detail
+
+	encoded, err := json.Marshal(events)
+	if err != nil {
+		logger.LogError("Error encoding " + header + " header: " + err.Error())
+		return h
+	}
+	h.header.Set(header, string(encoded))
+	return h
+}
+
+// Redirect makes the browser load another page entirely
+func (h *HTMXResponse) Redirect(url string) *HTMXResponse {
+	h.header.Set(HTMX_REDIRECT_HEADER, url)
+	return h
+}
+
+// Retarget swaps the response into the element matching the CSS selector instead of the request's target
+func (h *HTMXResponse) Retarget(selector string) *HTMXResponse {
+	h.header.Set(HTMX_RETARGET_HEADER, selector)
+	return h
+}
+
+// Reswap overrides how the response is swapped, e.g. "innerHTML" or "outerHTML"
+func (h *HTMXResponse) Reswap(swap string) *HTMXResponse {
+	h.header.Set(HTMX_RESWAP_HEADER, swap)
+	return h
+}
+
+// PushURL pushes a URL into the browser history, "false" prevents the request's own push
+func (h *HTMXResponse) PushURL(url string) *HTMXResponse {
+	h.header.Set(HTMX_PUSH_URL_HEADER, url)
+	return h
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTMXResponseTriggers(t *testing.T) {
+	w := httptest.NewRecorder()
+	HTMX(w).
+		Trigger("listing-updated", map[string]int{"total": 2}).
+		Trigger("notify", "saved").
+		TriggerAfterSwap("swapped", nil).
+		Retarget("#content-section")
+
+	if got, want := w.Header().Get(HTMX_TRIGGER_HEADER), `{"listing-updated":{"total":2},"notify":"saved"}`; got != want {
+		t.Errorf("HX-Trigger = %s, want %s", got, want)
+	}
+	if got, want := w.Header().Get(HTMX_TRIGGER_AFTER_SWAP_HEADER), `{"swapped":null}`; got != want {
+		t.Errorf("HX-Trigger-After-Swap = %s, want %s", got, want)
+	}
+	if got, want := w.Header().Get(HTMX_RETARGET_HEADER), "#content-section"; got != want {
+		t.Errorf("HX-Retarget = %s, want %s", got, want)
+	}
+}
+
+func TestIsHTMXRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected bool
+	}{
+		{"Regular request", nil, false},
+		{"HTMX request", map[string]string{HTMX_HEADER: "true"}, true},
+		{"History restore", map[string]string{HTMX_HEADER: "true", HTMX_HISTORY_RESTORE_HEADER: "true"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/blog", nil)
+			for key, value := range tt.headers {
+				r.Header.Set(key, value)
+			}
+			if got := isHTMXRequest(r); got != tt.expected {
+				t.Errorf("isHTMXRequest() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
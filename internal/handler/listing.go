@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/cache"
+	"aHobeychi/personal-website/internal/config"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultPageSize is used when site.pageSize is not configured
+const defaultPageSize = 10
+
+// ListingParams holds the query string options shared by the paginated listings
+type ListingParams struct {
+	Page  int
+	Tag   string
+	Sort  string
+	Query string
+}
+
+// parseListingParams reads ?page=, ?tag=, ?sort= and ?q= from the request
+// Sort values not listed in allowedSorts fall back to the first allowed value
+func parseListingParams(r *http.Request, allowedSorts ...string) ListingParams {
+	values := r.URL.Query()
+
+	params := ListingParams{
+		Page:  1,
+		Tag:   strings.TrimSpace(values.Get("tag")),
+		Query: strings.TrimSpace(values.Get("q")),
+	}
+
+	if page, err := strconv.Atoi(values.Get("page")); err == nil && page > 1 {
+		params.Page = page
+	}
+
+	if len(allowedSorts) > 0 {
+		params.Sort = allowedSorts[0]
+		for _, allowed := range allowedSorts {
+			if values.Get("sort") == allowed {
+				params.Sort = allowed
+			}
+		}
+	}
+
+	return params
+}
+
+// URL builds the listing URL for a page, omitting every option left at its default
+// so each page has a single canonical form
+func (p ListingParams) URL(path string, page int, defaultSort string) string {
+	values := url.Values{}
+	if p.Query != "" {
+		values.Set("q", p.Query)
+	}
+	if p.Tag != "" {
+		values.Set("tag", p.Tag)
+	}
+	if p.Sort != "" && p.Sort != defaultSort {
+		values.Set("sort", p.Sort)
+	}
+	if page > 1 {
+		values.Set("page", strconv.Itoa(page))
+	}
+
+	if len(values) == 0 {
+		return path
+	}
+	return path + "?" + values.Encode()
+}
+
+// pageSize returns the number of items shown per listing page
+func pageSize() int {
+	if size := config.Get().Site.PageSize; size > 0 {
+		return size
+	}
+	return defaultPageSize
+}
+
+// absoluteURL prefixes a site path with the configured domain
+func absoluteURL(path string) string {
+	c := config.Get()
+	scheme := "https"
+	if c.Server.Environment != "production" {
+		scheme = "http"
+	}
+	return scheme + "://" + c.Server.Domain + path
+}
+
+// addPaginationData adds the filters and the previous and next page URLs of a listing
+// Relative URLs are used by the page links, absolute ones by the <link> tags in the document head
+func addPaginationData[T any](data PageData, path string, params ListingParams, defaultSort string, result cache.Result[T]) {
+	data["Filters"] = params
+
+	if result.HasPrevious() {
+		previous := params.URL(path, result.PreviousPage(), defaultSort)
+		data["PrevPageURL"] = previous
+		data["PrevURL"] = absoluteURL(previous)
+	}
+
+	if result.HasNext() {
+		next := params.URL(path, result.NextPage(), defaultSort)
+		data["NextPageURL"] = next
+		data["NextURL"] = absoluteURL(next)
+	}
+}
+
+// isLoadMoreRequest reports whether an HTMX request asks for the next items of a listing
+// rather than the whole page
+func isLoadMoreRequest(r *http.Request, params ListingParams) bool {
+	return isHTMXRequest(r) && params.Page > 1
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/cache"
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"fmt"
+	"strings"
+)
+
+// blogListMarkdown renders a page of the blog list as a Markdown document
+func blogListMarkdown(blogs cache.Result[models.Blog], params ListingParams) string {
+	var b strings.Builder
+	b.WriteString("# Notes\n")
+
+	for _, blog := range blogs.Items {
+		fmt.Fprintf(&b, "\n## [%s](%s)\n\n", blog.Title, absoluteURL("/blog/"+blog.Id))
+		fmt.Fprintf(&b, "*%s*", formatDate(blog.PublishedDate))
+		if len(blog.Tags) > 0 {
+			fmt.Fprintf(&b, " · %s", strings.Join(blog.Tags, ", "))
+		}
+		fmt.Fprintf(&b, "\n\n%s\n", blog.Description)
+	}
+
+	writeMarkdownPagination(&b, "/blog.md", params, blogSorts[0], blogs)
+	return b.String()
+}
+
+// projectListMarkdown renders a page of the project list as a Markdown document
+func projectListMarkdown(projects cache.Result[models.Project], params ListingParams) string {
+	var b strings.Builder
+	b.WriteString("# Projects\n")
+
+	for _, project := range projects.Items {
+		if project.Link != "" {
+			fmt.Fprintf(&b, "\n## [%s](%s)\n\n", project.Name, project.Link)
+		} else {
+			fmt.Fprintf(&b, "\n## %s\n\n", project.Name)
+		}
+		fmt.Fprintf(&b, "%s\n", project.Description)
+		if len(project.Tags) > 0 {
+			fmt.Fprintf(&b, "\n*%s*\n", strings.Join(project.Tags, ", "))
+		}
+	}
+
+	writeMarkdownPagination(&b, "/project.md", params, projectSorts[0], projects)
+	return b.String()
+}
+
+// projectMarkdown renders the summary of a project page as a Markdown document
+func projectMarkdown(project models.Project, related []models.Blog) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", project.Name)
+
+	var details []string
+	if project.IsArchived() {
+		details = append(details, "Archived")
+	}
+	if project.Role != "" {
+		details = append(details, project.Role)
+	}
+	if period := projectPeriod(project); period != "" {
+		details = append(details, period)
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(&b, "*%s*\n\n", strings.Join(details, " · "))
+	}
+
+	fmt.Fprintf(&b, "%s\n", project.Description)
+	if project.Link != "" {
+		fmt.Fprintf(&b, "\n%s\n", project.Link)
+	}
+	if len(project.Tags) > 0 {
+		fmt.Fprintf(&b, "\n## Tech stack\n\n%s\n", strings.Join(project.Tags, ", "))
+	}
+	if len(related) > 0 {
+		b.WriteString("\n## Related notes\n\n")
+		for _, blog := range related {
+			fmt.Fprintf(&b, "- [%s](%s)\n", blog.Title, absoluteURL("/blog/"+blog.Id))
+		}
+	}
+	return b.String()
+}
+
+// skillsMarkdown renders the skills grouped by category as a Markdown document
+func skillsMarkdown(groups []models.SkillGroup) string {
+	var b strings.Builder
+	b.WriteString("# Skills\n")
+
+	for _, group := range groups {
+		fmt.Fprintf(&b, "\n## %s\n\n", group.Category)
+		for _, skill := range group.Skills {
+			if usage := skillUsage(skill); usage != "" {
+				fmt.Fprintf(&b, "- **%s**: %s\n", skill.Name, usage)
+			} else {
+				fmt.Fprintf(&b, "- **%s**\n", skill.Name)
+			}
+		}
+	}
+	return b.String()
+}
+
+// resumeMarkdown renders a resume as a Markdown document
+func resumeMarkdown(content resumeContent) string {
+	author := config.Get().Site.Author
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", author.Name)
+	if content.Title != "" {
+		fmt.Fprintf(&b, "**%s**\n\n", content.Title)
+	}
+	b.WriteString(strings.Join(resumeContacts(), " · ") + "\n")
+	if author.Summary != "" {
+		fmt.Fprintf(&b, "\n%s\n", author.Summary)
+	}
+	b.WriteString("\n## Work Experience\n")
+
+	for _, employer := range models.GroupByCompany(content.WorkExperience) {
+		if len(employer.Roles) == 1 {
+			experience := employer.Roles[0]
+			fmt.Fprintf(&b, "\n### %s, %s\n\n", experience.JobTitle, experience.CompanyName)
+			writeRoleMarkdown(&b, experience, "")
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s\n\n", employer.CompanyName)
+		fmt.Fprintf(&b, "*%s*\n", employerPeriod(employer))
+		if workplace := employer.Workplace(); workplace != "" {
+			fmt.Fprintf(&b, "\n%s\n", workplace)
+		}
+		for _, experience := range employer.Roles {
+			fmt.Fprintf(&b, "\n#### %s\n\n", experience.JobTitle)
+			writeRoleMarkdown(&b, experience, employer.Workplace())
+		}
+	}
+
+	if len(content.Projects) > 0 {
+		b.WriteString("\n## Projects\n")
+		for _, project := range content.Projects {
+			if project.Link != "" {
+				fmt.Fprintf(&b, "\n### [%s](%s)\n\n", project.Name, project.Link)
+			} else {
+				fmt.Fprintf(&b, "\n### %s\n\n", project.Name)
+			}
+			fmt.Fprintf(&b, "%s\n", project.Description)
+		}
+	}
+
+	if skills := resumeSkills(content); len(skills) > 0 {
+		b.WriteString("\n## Skills\n\n")
+		for _, group := range skills {
+			fmt.Fprintf(&b, "- **%s**: %s\n", group.Category, resumeSkillList(group))
+		}
+	}
+
+	if len(content.Certifications) > 0 {
+		b.WriteString("\n## Certifications\n\n")
+		for _, certification := range content.Certifications {
+			name := certification.Name
+			if certification.Url != "" {
+				name = fmt.Sprintf("[%s](%s)", certification.Name, certification.Url)
+			}
+			fmt.Fprintf(&b, "- %s, %s (%s)", name, certification.Issuer, formatDate(certification.DateReceived))
+			if details := certificationDetails(certification); details != "" {
+				fmt.Fprintf(&b, " · %s", details)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// writeRoleMarkdown appends the period, details, description, achievements and technologies of a role
+func writeRoleMarkdown(b *strings.Builder, experience models.WorkExperience, companyWorkplace string) {
+	fmt.Fprintf(b, "*%s*\n", resumePeriod(experience))
+	if details := roleDetails(experience, companyWorkplace); details != "" {
+		fmt.Fprintf(b, "\n%s\n", details)
+	}
+	if experience.Description != "" {
+		fmt.Fprintf(b, "\n%s\n", experience.Description)
+	}
+	if len(experience.Achievements) > 0 {
+		b.WriteString("\n")
+		for _, achievement := range experience.Achievements {
+			fmt.Fprintf(b, "- %s\n", achievement)
+		}
+	}
+	if len(experience.Tags) > 0 {
+		fmt.Fprintf(b, "\n%s\n", strings.Join(experience.Tags, ", "))
+	}
+}
+
+// writeMarkdownPagination appends links to the previous and next pages of a Markdown listing
+func writeMarkdownPagination[T any](b *strings.Builder, path string, params ListingParams, defaultSort string, result cache.Result[T]) {
+	var links []string
+	if result.HasPrevious() {
+		links = append(links, fmt.Sprintf("[Previous page](%s)", absoluteURL(params.URL(path, result.PreviousPage(), defaultSort))))
+	}
+	if result.HasNext() {
+		links = append(links, fmt.Sprintf("[Next page](%s)", absoluteURL(params.URL(path, result.NextPage(), defaultSort))))
+	}
+	if len(links) > 0 {
+		fmt.Fprintf(b, "\n---\n\n%s\n", strings.Join(links, " · "))
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// Site description and share image used when a page does not set its own
+const (
+	siteDescription = "Alex Hobeychi's personal website showcasing projects, blog posts, and resume"
+	siteImage       = "/static/images/headshot.png"
+)
+
+// siteTitle returns the site name configured in site.title
+func siteTitle() string {
+	return config.Get().Site.Title
+}
+
+// PageMeta describes a page to search engines and link previews
+// Handlers pass it to RenderTemplate under the "Meta" key, every field is optional
+type PageMeta struct {
+	Title         string
+	Description   string
+	Path          string // canonical path, defaults to the request path
+	Image         string // site path or absolute URL of the share image
+	Type          string // Open Graph type, defaults to "website"
+	PublishedTime models.Date
+	Tags          []string
+
+	// StructuredData lists the schema.org nodes describing the page, see structuredData
+	StructuredData []JSONLD
+}
+
+// BlogMeta derives the page metadata of a blog post
+func BlogMeta(blog models.Blog) PageMeta {
+	return PageMeta{
+		Title:          blog.Title,
+		Description:    blog.Description,
+		Path:           "/blog/" + blog.Id,
+		Image:          "/blog/" + blog.Id + "/og.png",
+		Type:           "article",
+		PublishedTime:  blog.PublishedDate,
+		Tags:           blog.Tags,
+		StructuredData: []JSONLD{blogPostingJSONLD(blog)},
+	}
+}
+
+// PageHead is the page metadata resolved for the document head
+type PageHead struct {
+	Title          string // document title, suffixed with the site name
+	SiteName       string
+	OpenGraphTitle string
+	Description    string
+	CanonicalURL   string
+	ImageURL       string
+	TwitterCard    string // "summary_large_image" for pages with their own image, "summary" otherwise
+	Type           string
+	PublishedTime  string
+	Tags           []string
+}
+
+// head fills the defaults of the metadata and turns its paths into absolute URLs
+func (m PageMeta) head(r *http.Request) PageHead {
+	head := PageHead{
+		Title:          siteTitle(),
+		SiteName:       siteTitle(),
+		OpenGraphTitle: siteTitle(),
+		Description:    siteDescription,
+		CanonicalURL:   absoluteURL(r.URL.Path),
+		ImageURL:       absoluteURL(siteImage),
+		TwitterCard:    "summary",
+		Type:           "website",
+		PublishedTime:  m.PublishedTime.String(),
+		Tags:           m.Tags,
+	}
+
+	if m.Title != "" {
+		head.Title = m.Title + " | " + siteTitle()
+		head.OpenGraphTitle = m.Title
+	}
+	if m.Description != "" {
+		head.Description = m.Description
+	}
+	if m.Path != "" {
+		head.CanonicalURL = absoluteURL(m.Path)
+	}
+	if m.Image != "" {
+		head.ImageURL = m.Image
+		if strings.HasPrefix(m.Image, "/") {
+			head.ImageURL = absoluteURL(m.Image)
+		}
+		head.TwitterCard = "summary_large_image"
+	}
+	if m.Type != "" {
+		head.Type = m.Type
+	}
+
+	return head
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPageMetaHead(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/home", nil)
+
+	head := PageMeta{}.head(r)
+	if head.Title != siteTitle() || head.SiteName != siteTitle() || head.Type != "website" || head.TwitterCard != "summary" {
+		t.Errorf("default head = %+v, want the site title, type website and a summary card", head)
+	}
+	if !strings.HasSuffix(head.CanonicalURL, "/home") {
+		t.Errorf("default CanonicalURL = %q, want the request path", head.CanonicalURL)
+	}
+
+	blog := models.Blog{Id: "post", Title: "Post", Description: "About", Tags: []string{"Go"}, PublishedDate: models.MustParseDate("2025-04-20")}
+	meta := BlogMeta(blog)
+	meta.Image = "/blog/post/og.png"
+	head = meta.head(r)
+	if head.Title != "Post | "+siteTitle() || head.OpenGraphTitle != "Post" {
+		t.Errorf("Title = %q, OpenGraphTitle = %q", head.Title, head.OpenGraphTitle)
+	}
+	if head.Type != "article" || head.PublishedTime != "2025-04-20" || head.TwitterCard != "summary_large_image" {
+		t.Errorf("blog head = %+v", head)
+	}
+	if !strings.HasSuffix(head.CanonicalURL, "/blog/post") || !strings.HasSuffix(head.ImageURL, "/blog/post/og.png") {
+		t.Errorf("CanonicalURL = %q, ImageURL = %q", head.CanonicalURL, head.ImageURL)
+	}
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Representations a page can be served as
+const (
+	FormatHTML     = "html"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+	FormatPDF      = "pdf"
+)
+
+// formatSuffixes maps the path suffixes that force a representation
+var formatSuffixes = map[string]string{
+	".json": FormatJSON,
+	".md":   FormatMarkdown,
+	".txt":  FormatText,
+	".pdf":  FormatPDF,
+}
+
+// mediaFormats maps the media types of the Accept header to a representation
+var mediaFormats = map[string]string{
+	"text/html":             FormatHTML,
+	"application/xhtml+xml": FormatHTML,
+	"application/json":      FormatJSON,
+	"text/markdown":         FormatMarkdown,
+	"text/x-markdown":       FormatMarkdown,
+	"text/plain":            FormatText,
+	"application/pdf":       FormatPDF,
+}
+
+// negotiateFormat picks the representation of a page from its path suffix, then from the Accept header
+// The response varies on Accept so caches keep the representations of a URL apart
+func negotiateFormat(w http.ResponseWriter, r *http.Request) string {
+	w.Header().Add("Vary", "Accept")
+
+	for suffix, format := range formatSuffixes {
+		if strings.HasSuffix(r.URL.Path, suffix) {
+			return format
+		}
+	}
+	return formatFromAccept(r.Header.Get("Accept"))
+}
+
+// trimFormatSuffix removes a representation suffix such as ".json" from a path
+func trimFormatSuffix(path string) string {
+	for suffix := range formatSuffixes {
+		if trimmed, ok := strings.CutSuffix(path, suffix); ok {
+			return trimmed
+		}
+	}
+	return path
+}
+
+// formatFromAccept returns the representation with the highest quality in an Accept header
+// Ties go to the first type listed, and HTML is used when no supported type is accepted
+func formatFromAccept(accept string) string {
+	best, bestQuality := FormatHTML, 0.0
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+		format, ok := mediaFormats[strings.ToLower(strings.TrimSpace(mediaType))]
+		if !ok {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if key == "q" {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = format, quality
+		}
+	}
+
+	return best
+}
+
+// writeMarkdown writes a Markdown document with an ETag derived from the body
+func writeMarkdown(w http.ResponseWriter, r *http.Request, markdown string) {
+	writeWithETag(w, r, http.StatusOK, "text/markdown; charset=utf-8", []byte(markdown))
+}
+
+// writeText writes a plain text document with an ETag derived from the body
+func writeText(w http.ResponseWriter, r *http.Request, text string) {
+	writeWithETag(w, r, http.StatusOK, "text/plain; charset=utf-8", []byte(text))
+}
+
+// writePDF writes a PDF document with an ETag derived from the body, shown inline under the given file name
+func writePDF(w http.ResponseWriter, r *http.Request, filename string, document []byte) {
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	writeWithETag(w, r, http.StatusOK, "application/pdf", document)
+}
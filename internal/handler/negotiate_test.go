@@ -0,0 +1,28 @@
+package handler
+
+import "testing"
+
+func TestFormatFromAccept(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		expected string
+	}{
+		{"Empty header", "", FormatHTML},
+		{"Browser default", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", FormatHTML},
+		{"Wildcard only", "*/*", FormatHTML},
+		{"JSON", "application/json", FormatJSON},
+		{"Markdown with parameters", "text/markdown; charset=utf-8", FormatMarkdown},
+		{"Quality decides", "text/html;q=0.5, application/json", FormatJSON},
+		{"Ties go to the first type", "text/markdown, application/json", FormatMarkdown},
+		{"Refused type is ignored", "application/json;q=0, text/markdown;q=0.1", FormatMarkdown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatFromAccept(tt.accept); got != tt.expected {
+				t.Errorf("formatFromAccept(%q) = %q, want %q", tt.accept, got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,24 +1,162 @@
 package handler
 
 import (
+	"aHobeychi/personal-website/internal/cache"
+	models "aHobeychi/personal-website/internal/domain"
 	"aHobeychi/personal-website/internal/parser"
 	"aHobeychi/personal-website/internal/util/logger"
+	"errors"
+	"fmt"
+	"html/template"
 	"net/http"
+	"os"
 )
 
+// Number of related notes listed on a project page
+const relatedBlogsLimit = 3
+
+// Sort orders accepted by the project list, the first one keeps the catalog order
+var projectSorts = []string{"featured", "name"}
+
+// projectListQuery builds the project query matching the listing options
+func projectListQuery(params ListingParams) *cache.Query[models.Project] {
+	query := parser.QueryProjects()
+	if params.Tag != "" {
+		query.Where(func(p models.Project) bool { return p.HasTag(params.Tag) })
+	}
+	if params.Query != "" {
+		query.Where(func(p models.Project) bool { return p.Matches(params.Query) })
+	}
+	if params.Sort == "name" {
+		query.SortBy(models.CompareProjectsByName)
+	}
+	return query
+}
+
 // ServeProjectsList handles the projects page
+// Supports ?page=, ?tag=, ?sort= and ?q=, and returns only the next items for HTMX "load more" requests
+// Also served as JSON or Markdown, see negotiateFormat
 func ServeProjectsList(w http.ResponseWriter, r *http.Request) {
-	// The ServeMux ensures this handler is only called for the exact path "/project"
+	// The ServeMux ensures this handler is only called for "/project" and its ".json" and ".md" variants
 	// so we don't need to check r.URL.Path here
+	format := negotiateFormat(w, r)
+	params := parseListingParams(r, projectSorts...)
+	query := projectListQuery(params)
+
+	if format == FormatJSON {
+		serveAPIList(w, r, query, params, projectSorts[0])
+		return
+	}
+
+	projects, err := query.Page(params.Page, pageSize()).Run()
+	if err != nil {
+		logger.LogError("Error parsing projects: " + err.Error())
+		http.Error(w, "Error loading project data", http.StatusInternalServerError)
+		return
+	}
 
-	projects, err := parser.ParseProjects()
+	// Pages after the last one would only be empty listings, keep crawlers from indexing them
+	if projects.IsPastEnd() {
+		http.Error(w, "Page not found", http.StatusNotFound)
+		return
+	}
+
+	if format == FormatMarkdown {
+		writeMarkdown(w, r, projectListMarkdown(projects, params))
+		return
+	}
+
+	allProjects, err := parser.ParseProjects()
 	if err != nil {
 		logger.LogError("Error parsing projects: " + err.Error())
+		http.Error(w, "Error loading project data", http.StatusInternalServerError)
 		return
 	}
+	var tags [][]string
+	for _, project := range allProjects {
+		tags = append(tags, project.Tags)
+	}
 
 	data := PageData{
-		"projects": projects,
+		"Meta":           PageMeta{Title: "Projects", Description: "Projects I've been building", Path: params.URL("/project", projects.Page(), projectSorts[0]), StructuredData: projectsJSONLD(projects.Items)},
+		"projects":       projects.Items,
+		"ProjectResults": projects,
+		"Tags":           models.DistinctTags(tags...),
+	}
+	addPaginationData(data, "/project", params, projectSorts[0], projects)
+
+	// Lets the page announce the number of matching projects after filtering
+	if isHTMXRequest(r) {
+		HTMX(w).Trigger("listing-updated", map[string]int{"total": projects.Total})
+	}
+
+	if isLoadMoreRequest(r, params) {
+		RenderTemplate(w, r, "projects-items", data)
+		return
 	}
+
 	RenderTemplate(w, r, "projects", data)
 }
+
+// APIProject is a project along with its rendered write-up and the notes sharing its tags
+type APIProject struct {
+	models.Project
+	ID           string        `json:"id"`
+	HTML         string        `json:"html,omitempty"`
+	RelatedBlogs []models.Blog `json:"relatedBlogs"`
+}
+
+// ServeProjectContent handles the detail page of a project at /project/{slug}
+// The Markdown write-up is optional, projects without one show their description
+// Also served as JSON or Markdown, see negotiateFormat
+func ServeProjectContent(w http.ResponseWriter, r *http.Request) {
+	format := negotiateFormat(w, r)
+
+	project, err := parser.GetProjectBySlug(trimFormatSuffix(r.PathValue("slug")))
+	if err != nil {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+	contentData, err := parser.GetProjectHTMLContent(project.ID())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.LogError("Error loading project write-up: " + err.Error())
+		http.Error(w, "Failed to load project content", http.StatusInternalServerError)
+		return
+	}
+
+	blogs, err := parser.ParseBlogs()
+	if err != nil {
+		logger.LogError("Error parsing blogs: " + err.Error())
+		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
+		return
+	}
+	related := models.BlogsSharingTags(project.Tags, blogs, relatedBlogsLimit)
+
+	switch format {
+	case FormatJSON:
+		setLastModified(w, parser.GetProjectRevisions(project.ID()))
+		writeJSON(w, r, http.StatusOK, APIProject{Project: project, ID: project.ID(), HTML: contentData, RelatedBlogs: related})
+		return
+	case FormatMarkdown:
+		setLastModified(w, parser.GetProjectRevisions(project.ID()))
+		writeMarkdown(w, r, projectMarkdown(project, related))
+		return
+	}
+
+	data := PageData{
+		"Meta":         PageMeta{Title: project.Name, Description: project.Description, Path: "/project/" + project.ID(), Tags: project.Tags, StructuredData: []JSONLD{projectJSONLD(project)}},
+		"Project":      project,
+		"Period":       projectPeriod(project),
+		"ContentData":  template.HTML(contentData), // Convert to template.HTML to prevent escaping
+		"RelatedBlogs": related,
+	}
+	RenderTemplate(w, r, "project-content", data)
+}
+
+// projectPeriod describes when a project ran, e.g. "March 2023 – Present", or "" when its start is unknown
+func projectPeriod(project models.Project) string {
+	if project.StartDate.IsOpen() {
+		return ""
+	}
+	return fmt.Sprintf("%s – %s", formatDate(project.StartDate), formatDate(project.EndDate))
+}
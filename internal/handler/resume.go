@@ -1,14 +1,48 @@
 package handler
 
 import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
 	"aHobeychi/personal-website/internal/parser"
 	"net/http"
+	"sort"
+	"time"
 )
 
+// resumeContent is the content of a resume, whole or tailored by a profile
+type resumeContent struct {
+	Path           string // canonical path without a format suffix
+	Profile        string // name of the profile, empty for the whole resume
+	Title          string // headline under the name, the latest job title unless a profile sets one
+	WorkExperience []models.WorkExperience
+	Certifications []models.Certification
+	Projects       []models.Project // only listed by profiles
+}
+
 // ServeResume handles the resume page
+// Also exported in the JSON Resume format, as Markdown, plain text or PDF, see negotiateFormat
+// Supports ?certifications=date|issuer, and ?expired=true to keep expired certifications in the exports
 func ServeResume(w http.ResponseWriter, r *http.Request) {
-	// The ServeMux ensures this handler is only called for the exact path "/resume"
+	// The ServeMux ensures this handler is only called for "/resume" and its export variants
 	// so we don't need to check r.URL.Path here
+	serveResume(w, r, "", nil)
+}
+
+// ServeResumeProfile handles the resume tailored to one of the profiles of the configuration
+// The profile is read from the {profile} path value, which may end with an export suffix such as ".pdf"
+func ServeResumeProfile(w http.ResponseWriter, r *http.Request) {
+	name := trimFormatSuffix(r.PathValue("profile"))
+	profile, ok := config.Get().Resume.Profiles[name]
+	if !ok {
+		http.Error(w, "Resume profile not found", http.StatusNotFound)
+		return
+	}
+	serveResume(w, r, name, &profile)
+}
+
+// serveResume renders the resume, selecting its entries with the profile when one is given
+func serveResume(w http.ResponseWriter, r *http.Request, name string, profile *config.ResumeProfile) {
+	format := negotiateFormat(w, r)
 
 	// Get the work experience data
 	workExperience, err := parser.ParseWorkExperiences()
@@ -24,9 +58,77 @@ func ServeResume(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	content := resumeContent{Path: "/resume", WorkExperience: workExperience, Certifications: certifications}
+	meta := PageMeta{Title: "Resume", Description: "Work experience and certifications", Path: "/resume"}
+
+	if profile != nil {
+		projects, err := parser.ParseProjects()
+		if err != nil {
+			http.Error(w, "Error loading projects data", http.StatusInternalServerError)
+			return
+		}
+
+		content = tailorResume(*profile, workExperience, certifications, projects)
+		content.Path = "/resume/" + name
+		content.Profile = name
+		meta = PageMeta{Title: "Resume", Description: profile.Description, Path: content.Path}
+		if profile.Title != "" {
+			meta.Title = profile.Title + " Resume"
+		}
+	}
+	if content.Title == "" {
+		content.Title = resumeLabel(content.WorkExperience)
+	}
+
+	// Profiles sorting by relevance keep their own certification order
+	order := certificationOrder(r)
+	if profile == nil || !profile.SortByRelevance {
+		content.Certifications = orderCertifications(content.Certifications, order)
+	}
+	// Expired certifications stay on the page, flagged, but are left out of exports unless asked for
+	if format != FormatHTML && !includeExpired(r) {
+		content.Certifications = models.ActiveCertifications(content.Certifications, time.Now())
+	}
+
+	switch format {
+	case FormatJSON:
+		writeJSON(w, r, http.StatusOK, jsonResume(content))
+		return
+	case FormatMarkdown:
+		writeMarkdown(w, r, resumeMarkdown(content))
+		return
+	case FormatText:
+		writeText(w, r, resumeText(content))
+		return
+	case FormatPDF:
+		writePDF(w, r, resumeFilename(content.Profile, ".pdf"), resumePDF(content))
+		return
+	}
+
+	meta.StructuredData = certificationsJSONLD(models.ActiveCertifications(content.Certifications, time.Now()))
 	data := PageData{
-		"WorkExperience": workExperience,
-		"Certifications": certifications,
+		"Meta":               meta,
+		"Resume":             content,
+		"Profiles":           resumeProfileNames(),
+		"WorkExperience":     content.WorkExperience,
+		"Employers":          models.GroupByCompany(content.WorkExperience),
+		"Certifications":     content.Certifications,
+		"CertificationOrder": order,
+		"Projects":           content.Projects,
+		"SkillGroups":        resumeSkills(content),
+	}
+	if order == "issuer" {
+		data["CertificationGroups"] = models.GroupCertificationsByIssuer(content.Certifications)
 	}
 	RenderTemplate(w, r, "resume", data)
 }
+
+// resumeProfileNames lists the profiles of the configuration alphabetically
+func resumeProfileNames() []string {
+	var names []string
+	for name := range config.Get().Resume.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
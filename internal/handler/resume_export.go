@@ -0,0 +1,463 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/util/pdf"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Schema the JSON export follows, see https://jsonresume.org/schema
+const jsonResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Width of the plain text export, in characters
+const resumeTextWidth = 80
+
+// Names of the networks of the sameAs profiles, by host
+var profileNetworks = map[string]string{
+	"linkedin.com":      "LinkedIn",
+	"github.com":        "GitHub",
+	"gitlab.com":        "GitLab",
+	"twitter.com":       "Twitter",
+	"x.com":             "X",
+	"stackoverflow.com": "Stack Overflow",
+}
+
+// JSONResume is the resume in the JSON Resume format
+type JSONResume struct {
+	Schema       string                  `json:"$schema"`
+	Basics       JSONResumeBasics        `json:"basics"`
+	Work         []JSONResumeWork        `json:"work"`
+	Certificates []JSONResumeCertificate `json:"certificates"`
+	Projects     []JSONResumeProject     `json:"projects,omitempty"`
+	Skills       []JSONResumeSkill       `json:"skills,omitempty"`
+	Meta         JSONResumeMeta          `json:"meta"`
+}
+
+type JSONResumeBasics struct {
+	Name     string              `json:"name"`
+	Label    string              `json:"label,omitempty"`
+	Image    string              `json:"image,omitempty"`
+	Email    string              `json:"email,omitempty"`
+	URL      string              `json:"url"`
+	Summary  string              `json:"summary,omitempty"`
+	Location JSONResumeLocation  `json:"location"`
+	Profiles []JSONResumeProfile `json:"profiles"`
+}
+
+type JSONResumeLocation struct {
+	City        string `json:"city,omitempty"`
+	Region      string `json:"region,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+type JSONResumeProfile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+type JSONResumeWork struct {
+	Name       string   `json:"name"`
+	Position   string   `json:"position"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"` // omitted for the current position
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+type JSONResumeCertificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer"`
+	URL    string `json:"url,omitempty"`
+}
+
+type JSONResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
+
+type JSONResumeSkill struct {
+	Name     string   `json:"name"`
+	Keywords []string `json:"keywords"`
+}
+
+type JSONResumeMeta struct {
+	Canonical string `json:"canonical"`
+	Version   string `json:"version"`
+}
+
+// jsonResume converts a resume to the JSON Resume format
+// The skills are listed by category, see resumeSkills
+func jsonResume(content resumeContent) JSONResume {
+	author := config.Get().Site.Author
+
+	resume := JSONResume{
+		Schema: jsonResumeSchema,
+		Basics: JSONResumeBasics{
+			Name:    author.Name,
+			Label:   content.Title,
+			Image:   absoluteURL(siteImage),
+			Email:   author.Email,
+			URL:     absoluteURL("/"),
+			Summary: author.Summary,
+			Location: JSONResumeLocation{
+				City:        author.Location.City,
+				Region:      author.Location.Region,
+				CountryCode: author.Location.CountryCode,
+			},
+			Profiles: resumeProfiles(author.SameAs),
+		},
+		Work:         make([]JSONResumeWork, len(content.WorkExperience)),
+		Certificates: make([]JSONResumeCertificate, len(content.Certifications)),
+		Meta:         JSONResumeMeta{Canonical: absoluteURL(content.Path + ".json"), Version: "v1.0.0"},
+	}
+
+	for i, experience := range content.WorkExperience {
+		resume.Work[i] = JSONResumeWork{
+			Name:      experience.CompanyName,
+			Position:  experience.JobTitle,
+			Location:  experience.Workplace(),
+			StartDate: experience.StartDate.String(),
+			EndDate:   experience.EndDate.String(),
+			Summary:   experience.Description,
+		}
+		for _, achievement := range experience.Achievements {
+			resume.Work[i].Highlights = append(resume.Work[i].Highlights, achievement.String())
+		}
+	}
+
+	for i, certification := range content.Certifications {
+		resume.Certificates[i] = JSONResumeCertificate{
+			Name:   certification.Name,
+			Date:   certification.DateReceived.String(),
+			Issuer: certification.Issuer,
+			URL:    certification.Url,
+		}
+	}
+
+	for _, project := range content.Projects {
+		resume.Projects = append(resume.Projects, JSONResumeProject{
+			Name:        project.Name,
+			Description: project.Description,
+			URL:         project.Link,
+			Keywords:    project.Tags,
+		})
+	}
+
+	for _, group := range resumeSkills(content) {
+		skill := JSONResumeSkill{Name: group.Category}
+		for _, s := range group.Skills {
+			skill.Keywords = append(skill.Keywords, s.Name)
+		}
+		resume.Skills = append(resume.Skills, skill)
+	}
+
+	return resume
+}
+
+// resumeLabel returns the title of the latest position
+func resumeLabel(experiences []models.WorkExperience) string {
+	if len(experiences) == 0 {
+		return ""
+	}
+	return experiences[0].JobTitle
+}
+
+// resumeProfiles describes the sameAs links of the site owner, naming the well known networks
+func resumeProfiles(links []string) []JSONResumeProfile {
+	profiles := make([]JSONResumeProfile, 0, len(links))
+	for _, link := range links {
+		u, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		network, ok := profileNetworks[host]
+		if !ok {
+			network = host
+		}
+
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		profiles = append(profiles, JSONResumeProfile{Network: network, Username: segments[len(segments)-1], URL: link})
+	}
+	return profiles
+}
+
+// resumeSkills indexes the tags of the resume's work experience and projects by category
+func resumeSkills(content resumeContent) []models.SkillGroup {
+	taxonomy := skillTaxonomy()
+	return taxonomy.Group(taxonomy.Skills(content.WorkExperience, content.Projects, nil, time.Now()))
+}
+
+// resumeSkillList lists the skills of a category with their time at work, e.g. "Go (2 yrs), Python"
+func resumeSkillList(group models.SkillGroup) string {
+	names := make([]string, len(group.Skills))
+	for i, skill := range group.Skills {
+		names[i] = skill.Name
+		if skill.Tenure.TotalMonths() > 0 {
+			names[i] += " (" + formatTenure(skill.Tenure) + ")"
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// resumeContacts returns the email, website and profile links printed under the name
+func resumeContacts() []string {
+	author := config.Get().Site.Author
+
+	var contacts []string
+	if author.Email != "" {
+		contacts = append(contacts, author.Email)
+	}
+	contacts = append(contacts, absoluteURL("/"))
+	return append(contacts, author.SameAs...)
+}
+
+// resumePeriod formats the dates and length of a position
+func resumePeriod(experience models.WorkExperience) string {
+	return fmt.Sprintf("%s – %s (%s)", formatDate(experience.StartDate), formatDate(experience.EndDate), formatTenure(experience.Tenure()))
+}
+
+// employerPeriod formats the dates of the first and last roles at a company and the total tenure
+func employerPeriod(employer models.Employer) string {
+	return fmt.Sprintf("%s – %s (%s)", formatDate(employer.StartDate()), formatDate(employer.EndDate()), formatTenure(employer.Tenure()))
+}
+
+// resumeText renders the resume as plain text wrapped at resumeTextWidth characters
+func resumeText(content resumeContent) string {
+	author := config.Get().Site.Author
+
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(author.Name) + "\n")
+	if content.Title != "" {
+		b.WriteString(content.Title + "\n")
+	}
+	for _, contact := range resumeContacts() {
+		b.WriteString(contact + "\n")
+	}
+
+	if author.Summary != "" {
+		b.WriteString("\nSUMMARY\n\n")
+		writeWrapped(&b, author.Summary, "")
+	}
+
+	b.WriteString("\nWORK EXPERIENCE\n")
+	for _, employer := range models.GroupByCompany(content.WorkExperience) {
+		if len(employer.Roles) == 1 {
+			experience := employer.Roles[0]
+			fmt.Fprintf(&b, "\n%s, %s\n", experience.JobTitle, experience.CompanyName)
+			writeRoleText(&b, experience, "")
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n%s\n", strings.ToUpper(employer.CompanyName))
+		b.WriteString(employerPeriod(employer) + "\n")
+		if workplace := employer.Workplace(); workplace != "" {
+			b.WriteString(workplace + "\n")
+		}
+		for _, experience := range employer.Roles {
+			fmt.Fprintf(&b, "\n%s\n", experience.JobTitle)
+			writeRoleText(&b, experience, employer.Workplace())
+		}
+	}
+
+	if len(content.Projects) > 0 {
+		b.WriteString("\nPROJECTS\n")
+		for _, project := range content.Projects {
+			b.WriteString("\n" + project.Name + "\n")
+			if project.Link != "" {
+				b.WriteString(project.Link + "\n")
+			}
+			b.WriteString("\n")
+			writeWrapped(&b, project.Description, "")
+		}
+	}
+
+	if skills := resumeSkills(content); len(skills) > 0 {
+		b.WriteString("\nSKILLS\n\n")
+		for _, group := range skills {
+			writeWrapped(&b, group.Category+": "+resumeSkillList(group), "  ")
+		}
+	}
+
+	if len(content.Certifications) > 0 {
+		b.WriteString("\nCERTIFICATIONS\n\n")
+		for _, certification := range content.Certifications {
+			writeWrapped(&b, fmt.Sprintf("- %s, %s (%s)", certification.Name, certification.Issuer, formatDate(certification.DateReceived)), "  ")
+			if details := certificationDetails(certification); details != "" {
+				b.WriteString("  " + details + "\n")
+			}
+			if certification.Url != "" {
+				b.WriteString("  " + certification.Url + "\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// writeRoleText writes the period, details, description, achievements and technologies of a role
+func writeRoleText(b *strings.Builder, experience models.WorkExperience, companyWorkplace string) {
+	b.WriteString(resumePeriod(experience) + "\n")
+	if details := roleDetails(experience, companyWorkplace); details != "" {
+		b.WriteString(details + "\n")
+	}
+	if experience.Description != "" {
+		b.WriteString("\n")
+		writeWrapped(b, experience.Description, "")
+	}
+	if len(experience.Achievements) > 0 {
+		b.WriteString("\n")
+		for _, achievement := range experience.Achievements {
+			writeWrapped(b, "- "+achievement.String(), "  ")
+		}
+	}
+	if len(experience.Tags) > 0 {
+		b.WriteString("\n")
+		writeWrapped(b, "Technologies: "+strings.Join(experience.Tags, ", "), "")
+	}
+}
+
+// writeWrapped writes text wrapped at resumeTextWidth characters, indenting every line after the first
+func writeWrapped(b *strings.Builder, text string, indent string) {
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len([]rune(line))+1+len([]rune(word)) > resumeTextWidth {
+			b.WriteString(line + "\n")
+			line = indent + word
+			continue
+		}
+		if line == "" {
+			line = word
+		} else {
+			line += " " + word
+		}
+	}
+	b.WriteString(line + "\n")
+}
+
+// Styles of the PDF export
+var (
+	resumeNameStyle    = pdf.Style{Size: 22, Bold: true}
+	resumeLabelStyle   = pdf.Style{Size: 12, Gray: 0.3, SpaceBefore: 2}
+	resumeContactStyle = pdf.Style{Size: 9, Gray: 0.4}
+	resumeSectionStyle = pdf.Style{Size: 13, Bold: true, SpaceBefore: 14}
+	resumeTitleStyle   = pdf.Style{Size: 11, Bold: true, SpaceBefore: 8}
+	resumeRoleStyle    = pdf.Style{Size: 10, Bold: true, SpaceBefore: 6}
+	resumeCompanyStyle = pdf.Style{Size: 10, Gray: 0.3}
+	resumeBodyStyle    = pdf.Style{Size: 10, SpaceBefore: 2}
+	resumeDetailStyle  = pdf.Style{Size: 9, Gray: 0.4, SpaceBefore: 2}
+)
+
+// resumePDF renders the resume as a PDF document
+func resumePDF(content resumeContent) []byte {
+	author := config.Get().Site.Author
+	doc := pdf.New(author.Name + " – Resume")
+
+	doc.Paragraph(author.Name, resumeNameStyle)
+	if content.Title != "" {
+		doc.Paragraph(content.Title, resumeLabelStyle)
+	}
+	doc.Paragraph(strings.Join(resumeContacts(), "  ·  "), resumeContactStyle)
+	if author.Summary != "" {
+		doc.Paragraph(author.Summary, resumeBodyStyle)
+	}
+
+	doc.Paragraph("Work Experience", resumeSectionStyle)
+	doc.Rule()
+	for _, employer := range models.GroupByCompany(content.WorkExperience) {
+		if len(employer.Roles) == 1 {
+			experience := employer.Roles[0]
+			doc.Row(experience.JobTitle, resumePeriod(experience), resumeTitleStyle)
+			company := experience.CompanyName
+			if details := roleDetails(experience, ""); details != "" {
+				company += "  ·  " + details
+			}
+			doc.Paragraph(company, resumeCompanyStyle)
+			writeRolePDF(doc, experience)
+			continue
+		}
+
+		doc.Row(employer.CompanyName, employerPeriod(employer), resumeTitleStyle)
+		if workplace := employer.Workplace(); workplace != "" {
+			doc.Paragraph(workplace, resumeCompanyStyle)
+		}
+		for _, experience := range employer.Roles {
+			doc.Row(experience.JobTitle, resumePeriod(experience), resumeRoleStyle)
+			if details := roleDetails(experience, employer.Workplace()); details != "" {
+				doc.Paragraph(details, resumeCompanyStyle)
+			}
+			writeRolePDF(doc, experience)
+		}
+	}
+
+	if len(content.Projects) > 0 {
+		doc.Paragraph("Projects", resumeSectionStyle)
+		doc.Rule()
+		for _, project := range content.Projects {
+			doc.Paragraph(project.Name, resumeTitleStyle)
+			doc.Paragraph(project.Description, resumeBodyStyle)
+			if project.Link != "" {
+				doc.Paragraph(project.Link, resumeDetailStyle)
+			}
+		}
+	}
+
+	if skills := resumeSkills(content); len(skills) > 0 {
+		doc.Paragraph("Skills", resumeSectionStyle)
+		doc.Rule()
+		for _, group := range skills {
+			doc.Paragraph(group.Category, resumeRoleStyle)
+			doc.Paragraph(resumeSkillList(group), resumeBodyStyle)
+		}
+	}
+
+	if len(content.Certifications) > 0 {
+		doc.Paragraph("Certifications", resumeSectionStyle)
+		doc.Rule()
+		for _, certification := range content.Certifications {
+			doc.Row(certification.Name, formatDate(certification.DateReceived), pdf.Style{Size: 10, Bold: true, SpaceBefore: 6})
+			issuer := certification.Issuer
+			if details := certificationDetails(certification); details != "" {
+				issuer += "  ·  " + details
+			}
+			doc.Paragraph(issuer, resumeCompanyStyle)
+			if certification.Url != "" {
+				doc.Paragraph(certification.Url, resumeDetailStyle)
+			}
+		}
+	}
+
+	return doc.Bytes()
+}
+
+// writeRolePDF draws the description, achievements and technologies of a role
+func writeRolePDF(doc *pdf.Document, experience models.WorkExperience) {
+	if experience.Description != "" {
+		doc.Paragraph(experience.Description, resumeBodyStyle)
+	}
+	for _, achievement := range experience.Achievements {
+		doc.Paragraph("•  "+achievement.String(), resumeBodyStyle)
+	}
+	if len(experience.Tags) > 0 {
+		doc.Paragraph(strings.Join(experience.Tags, "  ·  "), resumeDetailStyle)
+	}
+}
+
+// resumeFilename returns the name suggested when downloading a resume export, e.g. "alex-hobeychi-backend-resume.pdf"
+func resumeFilename(profile string, extension string) string {
+	parts := strings.Fields(strings.ToLower(config.Get().Site.Author.Name))
+	if profile != "" {
+		parts = append(parts, profile)
+	}
+	return strings.Join(append(parts, "resume"), "-") + extension
+}
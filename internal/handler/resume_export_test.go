@@ -0,0 +1,48 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"strings"
+	"testing"
+)
+
+func TestJSONResume(t *testing.T) {
+	experiences := []models.WorkExperience{
+		{JobTitle: "Developer", CompanyName: "Current", StartDate: models.MustParseDate("2025-08"), Tags: []string{"Go", "HTMX"}},
+		{JobTitle: "Intern", CompanyName: "Previous", StartDate: models.MustParseDate("2024-01"), EndDate: models.MustParseDate("2024-06"), Tags: []string{"go", "SQL"}},
+	}
+	certifications := []models.Certification{{Name: "AWS Developer", Issuer: "AWS", DateReceived: models.MustParseDate("2025-02-03")}}
+
+	resume := jsonResume(resumeContent{Path: "/resume", Title: resumeLabel(experiences), WorkExperience: experiences, Certifications: certifications})
+
+	if resume.Basics.Label != "Developer" {
+		t.Errorf("Label = %q, want the latest job title", resume.Basics.Label)
+	}
+	if !strings.HasSuffix(resume.Meta.Canonical, "/resume.json") {
+		t.Errorf("Canonical = %q", resume.Meta.Canonical)
+	}
+	if len(resume.Work) != 2 || resume.Work[0].EndDate != "" || resume.Work[1].StartDate != "2024-01" || resume.Work[1].EndDate != "2024-06" {
+		t.Errorf("Work = %+v", resume.Work)
+	}
+	if len(resume.Certificates) != 1 || resume.Certificates[0].Date != "2025-02-03" {
+		t.Errorf("Certificates = %+v", resume.Certificates)
+	}
+	if len(resume.Skills) != 1 || len(resume.Skills[0].Keywords) != 3 {
+		t.Errorf("Skills = %+v, want each technology once", resume.Skills)
+	}
+}
+
+func TestResumeProfiles(t *testing.T) {
+	profiles := resumeProfiles([]string{"https://www.linkedin.com/in/alex-hobeychi/", "https://github.com/aHobeychi", "https://example.com/me"})
+
+	expected := []JSONResumeProfile{
+		{Network: "LinkedIn", Username: "alex-hobeychi", URL: "https://www.linkedin.com/in/alex-hobeychi/"},
+		{Network: "GitHub", Username: "aHobeychi", URL: "https://github.com/aHobeychi"},
+		{Network: "example.com", Username: "me", URL: "https://example.com/me"},
+	}
+	for i, profile := range profiles {
+		if profile != expected[i] {
+			t.Errorf("profile %d = %+v, want %+v", i, profile, expected[i])
+		}
+	}
+}
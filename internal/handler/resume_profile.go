@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tailorResume selects and orders the entries of the resume relevant to a profile
+// Entries matching none of the profile's tags are hidden, or shortened when the profile asks for it
+func tailorResume(profile config.ResumeProfile, experiences []models.WorkExperience, certifications []models.Certification, projects []models.Project) resumeContent {
+	shorten := profile.Unmatched == "shorten"
+	content := resumeContent{Title: profile.Title}
+
+	var experienceScores []int
+	for _, experience := range experiences {
+		score := tagScore(profile.Tags, experience.Tags...)
+		if score == 0 {
+			if !shorten {
+				continue
+			}
+			experience.Description = ""
+			experience.Achievements = nil
+			experience.Tags = nil
+		}
+		content.WorkExperience = append(content.WorkExperience, experience)
+		experienceScores = append(experienceScores, score)
+	}
+
+	var certificationScores []int
+	for _, certification := range certifications {
+		score := tagScore(profile.Tags, certification.Name, certification.Issuer)
+		if score == 0 {
+			// A certification is a single line, there is nothing to shorten
+			continue
+		}
+		content.Certifications = append(content.Certifications, certification)
+		certificationScores = append(certificationScores, score)
+	}
+
+	var projectScores []int
+	for _, project := range projects {
+		score := tagScore(profile.Tags, project.Tags...)
+		if score == 0 {
+			continue
+		}
+		content.Projects = append(content.Projects, project)
+		projectScores = append(projectScores, score)
+	}
+
+	if profile.SortByRelevance {
+		sortByScore(content.WorkExperience, experienceScores)
+		sortByScore(content.Certifications, certificationScores)
+	}
+	sortByScore(content.Projects, projectScores)
+	if profile.MaxProjects > 0 && len(content.Projects) > profile.MaxProjects {
+		content.Projects = content.Projects[:profile.MaxProjects]
+	}
+
+	return content
+}
+
+// tagScore counts the tags found as whole words in any of the values
+func tagScore(tags []string, values ...string) int {
+	score := 0
+	for _, tag := range tags {
+		for _, value := range values {
+			if containsWords(value, tag) {
+				score++
+				break
+			}
+		}
+	}
+	return score
+}
+
+// containsWords reports whether words appear in text, ignoring case, not preceded or followed by a letter or digit
+// "AWS" is found in "AWS Batch" but "Go" is not found in "Google"
+func containsWords(text string, words string) bool {
+	text, words = strings.ToLower(text), strings.ToLower(strings.TrimSpace(words))
+	if words == "" {
+		return false
+	}
+
+	isWordRune := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], words)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(words)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
+
+// sortByScore orders items by decreasing score, keeping the current order between equal scores
+func sortByScore[T any](items []T, scores []int) {
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int { return scores[b] - scores[a] })
+
+	sorted := make([]T, len(items))
+	sortedScores := make([]int, len(scores))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+		sortedScores[i] = scores[index]
+	}
+	copy(items, sorted)
+	copy(scores, sortedScores)
+}
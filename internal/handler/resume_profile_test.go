@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"testing"
+)
+
+func TestContainsWords(t *testing.T) {
+	tests := []struct {
+		text     string
+		words    string
+		expected bool
+	}{
+		{"AWS Batch", "aws", true},
+		{"Angular 16", "Angular", true},
+		{"Spring Boot", "spring boot", true},
+		{"Google Cloud", "Go", false},
+		{"Microsoft Certified: Azure Fundamentals", "Azure", true},
+		{"Gopher, Go", "go", true},
+		{"Python", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := containsWords(tt.text, tt.words); got != tt.expected {
+			t.Errorf("containsWords(%q, %q) = %v, want %v", tt.text, tt.words, got, tt.expected)
+		}
+	}
+}
+
+func TestTailorResume(t *testing.T) {
+	experiences := []models.WorkExperience{
+		{JobTitle: "Frontend", Description: "React", Tags: []string{"React"}},
+		{JobTitle: "Backend", Description: "Spring", Tags: []string{"Spring Boot"}},
+		{JobTitle: "Cloud", Description: "AWS and Spring", Tags: []string{"Spring Batch", "AWS Lambda"}},
+	}
+	certifications := []models.Certification{{Name: "AWS Certified Developer", Issuer: "AWS"}, {Name: "Scrum Developer", Issuer: "Scrum.org"}}
+	projects := []models.Project{{Name: "Site", Tags: []string{"Go"}}, {Name: "Bot", Tags: []string{"Python"}}, {Name: "Lambda", Tags: []string{"AWS Lambda", "Go"}}}
+
+	profile := config.ResumeProfile{Title: "Backend", Tags: []string{"Spring", "AWS", "Go"}, MaxProjects: 2}
+	content := tailorResume(profile, experiences, certifications, projects)
+
+	if len(content.WorkExperience) != 2 || content.WorkExperience[0].JobTitle != "Backend" {
+		t.Errorf("hidden experiences: got %+v, want Backend then Cloud", content.WorkExperience)
+	}
+	if len(content.Certifications) != 1 || content.Certifications[0].Issuer != "AWS" {
+		t.Errorf("certifications = %+v, want only the AWS one", content.Certifications)
+	}
+	if len(content.Projects) != 2 || content.Projects[0].Name != "Lambda" || content.Projects[1].Name != "Site" {
+		t.Errorf("projects = %+v, want Lambda then Site", content.Projects)
+	}
+
+	profile.Unmatched = "shorten"
+	profile.SortByRelevance = true
+	content = tailorResume(profile, experiences, certifications, projects)
+
+	if len(content.WorkExperience) != 3 || content.WorkExperience[0].JobTitle != "Cloud" {
+		t.Fatalf("shortened experiences: got %+v, want Cloud first", content.WorkExperience)
+	}
+	if shortened := content.WorkExperience[2]; shortened.JobTitle != "Frontend" || shortened.Description != "" || shortened.Tags != nil {
+		t.Errorf("unmatched experience = %+v, want it without description or tags", shortened)
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// skillTaxonomy builds the skill aliases and categories of the configuration
+func skillTaxonomy() models.SkillTaxonomy {
+	skills := config.Get().Skills
+	taxonomy := models.NewSkillTaxonomy(skills.Aliases)
+	for _, category := range skills.Categories {
+		taxonomy = taxonomy.AddCategory(category.Name, category.Skills...)
+	}
+	return taxonomy
+}
+
+// ServeSkills handles the skills page, indexing the tags of the work experience, projects and blogs
+// Also served as JSON or Markdown, see negotiateFormat
+func ServeSkills(w http.ResponseWriter, r *http.Request) {
+	// The ServeMux ensures this handler is only called for "/skills" and its ".json" and ".md" variants
+	// so we don't need to check r.URL.Path here
+	format := negotiateFormat(w, r)
+
+	workExperience, err := parser.ParseWorkExperiences()
+	if err != nil {
+		logger.LogError("Error parsing work experience: " + err.Error())
+		http.Error(w, "Error loading work experience data", http.StatusInternalServerError)
+		return
+	}
+
+	projects, err := parser.ParseProjects()
+	if err != nil {
+		logger.LogError("Error parsing projects: " + err.Error())
+		http.Error(w, "Error loading project data", http.StatusInternalServerError)
+		return
+	}
+
+	blogs, err := parser.ParseBlogs()
+	if err != nil {
+		logger.LogError("Error parsing blogs: " + err.Error())
+		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
+		return
+	}
+
+	taxonomy := skillTaxonomy()
+	groups := taxonomy.Group(taxonomy.Skills(workExperience, projects, blogs, time.Now()))
+
+	switch format {
+	case FormatJSON:
+		writeJSON(w, r, http.StatusOK, groups)
+		return
+	case FormatMarkdown:
+		writeMarkdown(w, r, skillsMarkdown(groups))
+		return
+	}
+
+	data := PageData{
+		"Meta":        PageMeta{Title: "Skills", Description: "Technologies I have worked with, from work experience, projects and notes", Path: "/skills"},
+		"SkillGroups": groups,
+	}
+	RenderTemplate(w, r, "skills", data)
+}
+
+// skillUsage summarizes where a skill was used, e.g. "2 yrs 3 mos at work · 2 projects · 1 note"
+func skillUsage(skill models.Skill) string {
+	var parts []string
+	if skill.Tenure.TotalMonths() > 0 {
+		parts = append(parts, formatTenure(skill.Tenure)+" at work")
+	}
+	if skill.Projects > 0 {
+		parts = append(parts, plural(skill.Projects, "project", "projects"))
+	}
+	if skill.Blogs > 0 {
+		parts = append(parts, plural(skill.Blogs, "note", "notes"))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// plural formats a count with the singular or plural form of a noun
+func plural(count int, singular string, pluralForm string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+	return fmt.Sprintf("%d %s", count, pluralForm)
+}
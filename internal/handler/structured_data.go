@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+	"net/url"
+	"strings"
+)
+
+// JSONLD is a schema.org node rendered as JSON-LD in the document head
+type JSONLD map[string]any
+
+// Hosts serving source code, projects linking to them are described as SoftwareSourceCode
+var sourceCodeHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// personID identifies the site owner so other nodes can refer to them
+func personID() string {
+	return absoluteURL("/#person")
+}
+
+// structuredData returns the JSON-LD graph of a page: the site owner followed by the page's own nodes
+// Credentials are attached to the site owner rather than listed on their own
+func structuredData(meta PageMeta) JSONLD {
+	person := personJSONLD()
+	graph := []JSONLD{person}
+
+	var credentials []JSONLD
+	for _, node := range meta.StructuredData {
+		if node["@type"] == "EducationalOccupationalCredential" {
+			credentials = append(credentials, node)
+			continue
+		}
+		graph = append(graph, node)
+	}
+	if len(credentials) > 0 {
+		person["hasCredential"] = credentials
+	}
+
+	return JSONLD{
+		"@context": "https://schema.org",
+		"@graph":   graph,
+	}
+}
+
+// personJSONLD describes the site owner, taking the job title from the latest work experience
+func personJSONLD() JSONLD {
+	author := config.Get().Site.Author
+
+	person := JSONLD{
+		"@type": "Person",
+		"@id":   personID(),
+		"name":  author.Name,
+		"url":   absoluteURL("/"),
+		"image": absoluteURL(siteImage),
+	}
+	if len(author.SameAs) > 0 {
+		person["sameAs"] = author.SameAs
+	}
+
+	experiences, err := parser.ParseWorkExperiences(1)
+	if err != nil {
+		logger.LogWarning("Error loading work experience for structured data: " + err.Error())
+		return person
+	}
+	if len(experiences) > 0 {
+		latest := experiences[0]
+		person["jobTitle"] = latest.JobTitle
+		if latest.IsCurrent() {
+			person["worksFor"] = JSONLD{"@type": "Organization", "name": latest.CompanyName}
+		}
+	}
+
+	return person
+}
+
+// blogPostingJSONLD describes a blog post
+func blogPostingJSONLD(blog models.Blog) JSONLD {
+	posting := JSONLD{
+		"@type":            "BlogPosting",
+		"@id":              absoluteURL("/blog/" + blog.Id),
+		"headline":         blog.Title,
+		"description":      blog.Description,
+		"url":              absoluteURL("/blog/" + blog.Id),
+		"mainEntityOfPage": absoluteURL("/blog/" + blog.Id),
+		"image":            absoluteURL("/blog/" + blog.Id + "/og.png"),
+		"author":           JSONLD{"@id": personID()},
+	}
+	if !blog.PublishedDate.IsOpen() {
+		posting["datePublished"] = blog.PublishedDate.String()
+	}
+	if blog.WasUpdated() {
+		posting["dateModified"] = blog.UpdatedDate.String()
+	}
+	if blog.WordCount > 0 {
+		posting["wordCount"] = blog.WordCount
+	}
+	if len(blog.Tags) > 0 {
+		posting["keywords"] = strings.Join(blog.Tags, ", ")
+	}
+	return posting
+}
+
+// projectJSONLD describes a project, as SoftwareSourceCode when it links to a code host
+func projectJSONLD(project models.Project) JSONLD {
+	node := JSONLD{
+		"@type":       "CreativeWork",
+		"name":        project.Name,
+		"description": project.Description,
+		"author":      JSONLD{"@id": personID()},
+	}
+	if project.Link != "" {
+		node["url"] = project.Link
+		if isSourceCodeLink(project.Link) {
+			node["@type"] = "SoftwareSourceCode"
+			node["codeRepository"] = project.Link
+		}
+	}
+	if len(project.Tags) > 0 {
+		node["keywords"] = strings.Join(project.Tags, ", ")
+	}
+	return node
+}
+
+// isSourceCodeLink reports whether a link points to a source code host
+func isSourceCodeLink(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, sourceHost := range sourceCodeHosts {
+		if host == sourceHost {
+			return true
+		}
+	}
+	return false
+}
+
+// certificationJSONLD describes a certification held by the site owner
+func certificationJSONLD(certification models.Certification) JSONLD {
+	credential := JSONLD{
+		"@type":              "EducationalOccupationalCredential",
+		"name":               certification.Name,
+		"credentialCategory": "certification",
+		"recognizedBy":       JSONLD{"@type": "Organization", "name": certification.Issuer},
+	}
+	if certification.Url != "" {
+		credential["url"] = certification.Url
+	}
+	if !certification.DateReceived.IsOpen() {
+		credential["dateCreated"] = certification.DateReceived.String()
+	}
+	if certification.CredentialID != "" {
+		credential["identifier"] = certification.CredentialID
+	}
+	return credential
+}
+
+// websiteJSONLD describes the site itself
+func websiteJSONLD() JSONLD {
+	return JSONLD{
+		"@type":  "WebSite",
+		"name":   siteTitle(),
+		"url":    absoluteURL("/"),
+		"author": JSONLD{"@id": personID()},
+	}
+}
+
+// blogJSONLD describes the blog along with a page of its posts
+func blogJSONLD(blogs []models.Blog) JSONLD {
+	postings := make([]JSONLD, len(blogs))
+	for i, blog := range blogs {
+		postings[i] = blogPostingJSONLD(blog)
+	}
+	return JSONLD{
+		"@type":    "Blog",
+		"name":     "Notes",
+		"url":      absoluteURL("/blog"),
+		"author":   JSONLD{"@id": personID()},
+		"blogPost": postings,
+	}
+}
+
+// projectsJSONLD describes a list of projects
+func projectsJSONLD(projects []models.Project) []JSONLD {
+	nodes := make([]JSONLD, len(projects))
+	for i, project := range projects {
+		nodes[i] = projectJSONLD(project)
+	}
+	return nodes
+}
+
+// certificationsJSONLD describes a list of certifications
+func certificationsJSONLD(certifications []models.Certification) []JSONLD {
+	nodes := make([]JSONLD, len(certifications))
+	for i, certification := range certifications {
+		nodes[i] = certificationJSONLD(certification)
+	}
+	return nodes
+}
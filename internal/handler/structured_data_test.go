@@ -0,0 +1,56 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"testing"
+)
+
+func TestStructuredData(t *testing.T) {
+	setupAPIRepository(t)
+
+	blog := models.Blog{Id: "post", Title: "Post", Tags: []string{"Go", "HTMX"}, PublishedDate: models.MustParseDate("2025-04-20")}
+	data := structuredData(PageMeta{StructuredData: []JSONLD{
+		blogPostingJSONLD(blog),
+		certificationJSONLD(models.Certification{Name: "AWS Developer", Issuer: "AWS"}),
+	}})
+
+	graph := data["@graph"].([]JSONLD)
+	if len(graph) != 2 {
+		t.Fatalf("graph has %d nodes, want the person and the blog post", len(graph))
+	}
+
+	person := graph[0]
+	if person["@type"] != "Person" {
+		t.Errorf("first node = %v, want the person", person["@type"])
+	}
+	if credentials, _ := person["hasCredential"].([]JSONLD); len(credentials) != 1 {
+		t.Errorf("hasCredential = %v, want the certification attached to the person", person["hasCredential"])
+	}
+
+	posting := graph[1]
+	if posting["@type"] != "BlogPosting" || posting["datePublished"] != "2025-04-20" || posting["keywords"] != "Go, HTMX" {
+		t.Errorf("blog posting = %v", posting)
+	}
+	if author, _ := posting["author"].(JSONLD); author["@id"] != person["@id"] {
+		t.Errorf("blog author = %v, want a reference to the person", posting["author"])
+	}
+}
+
+func TestProjectJSONLD(t *testing.T) {
+	tests := []struct {
+		link         string
+		expectedType string
+	}{
+		{link: "https://github.com/aHobeychi/personal-website", expectedType: "SoftwareSourceCode"},
+		{link: "https://www.gitlab.com/group/project", expectedType: "SoftwareSourceCode"},
+		{link: "https://example.com/demo", expectedType: "CreativeWork"},
+		{link: "", expectedType: "CreativeWork"},
+	}
+
+	for _, tt := range tests {
+		node := projectJSONLD(models.Project{Name: "Project", Link: tt.link})
+		if node["@type"] != tt.expectedType {
+			t.Errorf("projectJSONLD(%q) type = %v, want %s", tt.link, node["@type"], tt.expectedType)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/util/locale"
+	"fmt"
+	"html/template"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// templateFuncs are the helper functions available to every template
+var templateFuncs = template.FuncMap{
+	"formatDate":          formatDate,
+	"formatTenure":        formatTenure,
+	"roleDetails":         roleDetails,
+	"dict":                dict,
+	"skillUsage":          skillUsage,
+	"certificationStatus": certificationStatus,
+	"issuerLogo":          issuerLogo,
+	"timelineKindLabel":   timelineKindLabel,
+	"plural":              plural,
+	"authorName":          authorName,
+	"authorGivenName":     authorGivenName,
+}
+
+// siteLocale returns the locale configured for display
+func siteLocale() string {
+	return config.Get().Site.Locale
+}
+
+// authorName returns the name of the site owner
+func authorName() string {
+	return config.Get().Site.Author.Name
+}
+
+// authorGivenName returns the first word of the site owner's name
+func authorGivenName() string {
+	if fields := strings.Fields(authorName()); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// formatDate formats a catalog date in the configured locale at the date's precision
+// Open-ended dates are rendered as "Present"
+func formatDate(d models.Date) string {
+	switch {
+	case d.IsOpen():
+		return locale.Present(siteLocale())
+	case d.MonthOnly:
+		return locale.FormatMonth(d.Time, siteLocale())
+	default:
+		return locale.FormatDay(d.Time, siteLocale())
+	}
+}
+
+// formatTenure formats a tenure in the configured locale, e.g. "1 yr 2 mos"
+func formatTenure(t models.Tenure) string {
+	return locale.FormatDuration(t.Years, t.Months, siteLocale())
+}
+
+// roleDetails describes the employment type and workplace of a role, e.g. "Contract · Montreal, QC · Remote"
+// The workplace is left out when it is the one already shown for the company
+func roleDetails(experience models.WorkExperience, companyWorkplace string) string {
+	var parts []string
+	if experience.EmploymentType != "" {
+		first, size := utf8.DecodeRuneInString(experience.EmploymentType)
+		parts = append(parts, string(unicode.ToUpper(first))+experience.EmploymentType[size:])
+	}
+	if workplace := experience.Workplace(); workplace != "" && workplace != companyWorkplace {
+		parts = append(parts, workplace)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// dict builds a map from alternating keys and values, to pass several values to a nested template
+func dict(pairs ...any) (map[string]any, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("dict expects key and value pairs, got %d arguments", len(pairs))
+	}
+	values := make(map[string]any, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key %v is not a string", pairs[i])
+		}
+		values[key] = pairs[i+1]
+	}
+	return values, nil
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"testing"
+)
+
+func TestRoleDetails(t *testing.T) {
+	tests := []struct {
+		name             string
+		experience       models.WorkExperience
+		companyWorkplace string
+		want             string
+	}{
+		{"type and workplace", models.WorkExperience{EmploymentType: models.EmploymentContract, Location: "Montreal, QC", Remote: true}, "", "Contract · Montreal, QC · Remote"},
+		{"workplace of the company", models.WorkExperience{EmploymentType: models.EmploymentFullTime, Location: "Montreal, QC"}, "Montreal, QC", "Full-time"},
+		{"multi-byte first letter", models.WorkExperience{EmploymentType: "étudiant"}, "", "Étudiant"},
+		{"nothing to describe", models.WorkExperience{}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roleDetails(tt.experience, tt.companyWorkplace); got != tt.want {
+				t.Errorf("roleDetails() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/parser"
+	"aHobeychi/personal-website/internal/util/logger"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Number of years rendered at once, older years are loaded as the reader scrolls
+const timelineYearsPerPage = 2
+
+// TimelineParams holds the query string options of the timeline
+type TimelineParams struct {
+	Kind   string // ?type=, one of models.TimelineKinds, empty for every kind
+	Tag    string // ?tag=
+	Before int    // ?before=, lists the years before this one, 0 starts from the latest year
+}
+
+// parseTimelineParams reads ?type=, ?tag= and ?before= from the request, ignoring unknown types
+func parseTimelineParams(r *http.Request) TimelineParams {
+	values := r.URL.Query()
+
+	params := TimelineParams{Tag: strings.TrimSpace(values.Get("tag"))}
+	if kind := strings.ToLower(values.Get("type")); slices.Contains(models.TimelineKinds, kind) {
+		params.Kind = kind
+	}
+	if before, err := strconv.Atoi(values.Get("before")); err == nil && before > 0 {
+		params.Before = before
+	}
+	return params
+}
+
+// URL builds the timeline URL listing the years before a year, 0 for the first years
+func (p TimelineParams) URL(before int) string {
+	values := url.Values{}
+	if p.Kind != "" {
+		values.Set("type", p.Kind)
+	}
+	if p.Tag != "" {
+		values.Set("tag", p.Tag)
+	}
+	if before > 0 {
+		values.Set("before", strconv.Itoa(before))
+	}
+
+	if len(values) == 0 {
+		return "/timeline"
+	}
+	return "/timeline?" + values.Encode()
+}
+
+// ServeTimeline handles the timeline merging blogs, projects, jobs and certifications by year
+// Supports ?type= and ?tag=, and returns only the next years for HTMX requests with ?before=
+func ServeTimeline(w http.ResponseWriter, r *http.Request) {
+	params := parseTimelineParams(r)
+
+	blogs, err := parser.ParseBlogs()
+	if err != nil {
+		logger.LogError("Error parsing blogs: " + err.Error())
+		http.Error(w, "Error loading blog data", http.StatusInternalServerError)
+		return
+	}
+
+	projects, err := parser.ParseProjects()
+	if err != nil {
+		logger.LogError("Error parsing projects: " + err.Error())
+		http.Error(w, "Error loading project data", http.StatusInternalServerError)
+		return
+	}
+
+	workExperience, err := parser.ParseWorkExperiences()
+	if err != nil {
+		logger.LogError("Error parsing work experience: " + err.Error())
+		http.Error(w, "Error loading work experience data", http.StatusInternalServerError)
+		return
+	}
+
+	certifications, err := parser.ParseCertifications()
+	if err != nil {
+		logger.LogError("Error parsing certifications: " + err.Error())
+		http.Error(w, "Error loading certification data", http.StatusInternalServerError)
+		return
+	}
+
+	entries := models.NewTimeline(blogs, projects, workExperience, certifications)
+	years := models.GroupTimelineByYear(models.FilterTimeline(entries, params.Kind, params.Tag))
+
+	// Skip the years already shown, then keep a page of them
+	if params.Before > 0 {
+		start := slices.IndexFunc(years, func(y models.TimelineYear) bool { return y.Year < params.Before })
+		if start < 0 {
+			start = len(years)
+		}
+		years = years[start:]
+	}
+	data := PageData{
+		"Meta":    PageMeta{Title: "Timeline", Description: "Notes, projects, jobs and certifications over the years", Path: params.URL(params.Before)},
+		"Filters": params,
+		"Kinds":   models.TimelineKinds,
+	}
+	if len(years) > timelineYearsPerPage {
+		years = years[:timelineYearsPerPage]
+		data["NextPageURL"] = params.URL(years[len(years)-1].Year)
+	}
+	data["Years"] = years
+
+	if isHTMXRequest(r) && params.Before > 0 {
+		RenderTemplate(w, r, "timeline-years", data)
+		return
+	}
+
+	var tags [][]string
+	for _, entry := range entries {
+		tags = append(tags, entry.Tags)
+	}
+	data["Tags"] = models.DistinctTags(tags...)
+
+	RenderTemplate(w, r, "timeline", data)
+}
+
+// timelineKindLabel names a kind of timeline entry for display
+func timelineKindLabel(kind string) string {
+	switch kind {
+	case models.TimelineBlog:
+		return "Note"
+	case models.TimelineProject:
+		return "Project"
+	case models.TimelineJob:
+		return "Job"
+	case models.TimelineCertification:
+		return "Certification"
+	}
+	return kind
+}
@@ -2,73 +2,65 @@ package parser
 
 import (
 	"aHobeychi/personal-website/internal/cache"
-	"aHobeychi/personal-website/internal/config"
 	models "aHobeychi/personal-website/internal/domain"
-	"aHobeychi/personal-website/internal/util/logger"
-	"os"
-	"time"
+	"aHobeychi/personal-website/internal/preprocessor"
 )
 
-var blogCache *cache.Cache[models.Blog]
-
-func init() {
-	// Initialize the blog cache
-	blogCache = cache.NewCache[models.Blog](
-		config.Get().Paths.BlogsJSON,
-		time.Duration(config.Get().Features.CacheTTL*int(time.Minute)),
-		"blog",
-	)
-}
-
 // SetDisableBlogCache allows toggling the blog caching mechanism on or off
 func SetDisableBlogCache(flag bool) {
-	blogCache.SetDisabled(flag)
+	if r, ok := jsonRepository(); ok {
+		r.Blogs.SetDisabled(flag)
+	}
 }
 
-// ParseBlogs retrieves a list of blogs, either from cache or from file
+// ParseBlogs retrieves a list of blogs from the content repository, newest first
 // Optional limit parameter controls the maximum number of blogs returned
 // Returns a slice of Blog models and any error encountered
 func ParseBlogs(limit ...int) ([]models.Blog, error) {
-	return blogCache.Get(limit...)
+	blogs, err := Repository().ListBlogs()
+	return applyLimit(withRevisionDates(newestFirst(blogs, models.CompareBlogs)), err, limit...)
+}
+
+// QueryBlogs starts a query over every blog, newest first unless another order is set
+func QueryBlogs() *cache.Query[models.Blog] {
+	return cache.NewQuery(func() ([]models.Blog, error) {
+		return ParseBlogs()
+	})
+}
+
+// QueryBlogsWithWordCounts starts a query like QueryBlogs over blogs carrying the word counts of their bodies
+func QueryBlogsWithWordCounts() *cache.Query[models.Blog] {
+	return cache.NewQuery(func() ([]models.Blog, error) {
+		blogs, err := ParseBlogs()
+		return WithWordCounts(blogs), err
+	})
 }
 
 // GetBlogHTMLContent returns the HTML content of a blog post by its ID.
 func GetBlogHTMLContent(blogId string) (string, error) {
-	blogPath := config.Get().Paths.BlogHTML + "/" + blogId + ".html"
-	content, err := os.ReadFile(blogPath)
-	if err != nil {
-		logger.ErrorLogger.Println("Error reading blog content file:", err)
-		return "", err
-	}
-	contentString := string(content)
-	logger.DebugLogger.Printf("HTML content retrieved for blog ID: %s", blogId)
-	return contentString, nil
+	return Repository().GetBlogHTML(blogId)
 }
 
+// GetBlogMarkdown returns the Markdown source of a blog post by its ID
+func GetBlogMarkdown(blogId string) (string, error) {
+	return Repository().GetBlogMarkdown(blogId)
+}
+
+// GetBlogOGImagePath returns the path of the preview image of a blog post, generating it when missing
+func GetBlogOGImagePath(blogId string) (string, error) {
+	return preprocessor.GetBlogOGImage(blogId, GetBlogProvider())
+}
+
+// GetBlogByID returns the blog with the given ID, or os.ErrNotExist
 func GetBlogByID(id string) (models.Blog, error) {
-	blogs, err := ParseBlogs()
+	blog, err := Repository().GetBlog(id)
 	if err != nil {
-		return models.Blog{}, err
+		return blog, err
 	}
-
-	for _, blog := range blogs {
-		if blog.Id == id {
-			return blog, nil
-		}
-	}
-
-	return models.Blog{}, os.ErrNotExist
+	return withRevisionDates([]models.Blog{blog})[0], nil
 }
 
 // GetBlogTableOfContents returns the pre-generated table of contents HTML for a blog post
 func GetBlogTableOfContents(blogId string) (string, error) {
-	tocPath := config.Get().Paths.TocHTML + "/" + blogId + "-toc.html"
-	content, err := os.ReadFile(tocPath)
-	if err != nil {
-		logger.ErrorLogger.Println("Error reading blog table of contents file:", err)
-		return "", err
-	}
-	contentString := string(content)
-	logger.DebugLogger.Printf("Table of contents retrieved for blog ID: %s", blogId)
-	return contentString, nil
+	return Repository().GetBlogTableOfContents(blogId)
 }
@@ -0,0 +1,102 @@
+package parser
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/repository"
+	"aHobeychi/personal-website/internal/util/logger"
+	"slices"
+	"sync"
+)
+
+// Number of related posts kept for every blog
+const relatedBlogsLimit = 3
+
+var (
+	blogIndex      *models.BlogIndex
+	blogIndexMutex sync.RWMutex
+)
+
+// GetBlogIndex returns the previous, next and related posts of every blog
+// The index is built when the blog cache loads so serving a post does not depend on the number of posts;
+// it is rebuilt on every call while the blog cache is disabled so edits show up right away
+func GetBlogIndex() (*models.BlogIndex, error) {
+	if r, ok := jsonRepository(); ok && r.Blogs.IsDisabled() {
+		blogs, err := r.ListBlogs()
+		if err != nil {
+			return nil, err
+		}
+		return buildBlogIndex(blogs), nil
+	}
+
+	if index := currentBlogIndex(); index != nil {
+		return index, nil
+	}
+
+	// Listing the blogs loads the cache of a JSON repository, which builds the index on load
+	blogs, err := Repository().ListBlogs()
+	if err != nil {
+		return nil, err
+	}
+	if index := currentBlogIndex(); index != nil {
+		return index, nil
+	}
+
+	// Backends without a blog cache build the index on first use
+	return setBlogIndex(buildBlogIndex(blogs)), nil
+}
+
+// currentBlogIndex returns the blog index, nil until it is built
+func currentBlogIndex() *models.BlogIndex {
+	blogIndexMutex.RLock()
+	defer blogIndexMutex.RUnlock()
+	return blogIndex
+}
+
+// WithWordCounts returns a copy of blogs with the word counts of their bodies, taken from the blog index
+// Only pages showing reading times need them, as the index is rebuilt on every call while the blog cache is disabled
+func WithWordCounts(blogs []models.Blog) []models.Blog {
+	if len(blogs) == 0 {
+		return blogs
+	}
+	index, err := GetBlogIndex()
+	if err != nil {
+		logger.LogWarning("Error loading the blog index: " + err.Error())
+		return blogs
+	}
+
+	counted := slices.Clone(blogs)
+	for i, blog := range counted {
+		counted[i].WordCount = index.WordCount(blog.Id)
+	}
+	return counted
+}
+
+// buildBlogIndex reads the body of every blog and ranks their related posts
+func buildBlogIndex(blogs []models.Blog) *models.BlogIndex {
+	bodies := make(map[string]string, len(blogs))
+	for _, blog := range blogs {
+		// Posts without a body are still related through their tags
+		if body, err := Repository().GetBlogHTML(blog.Id); err == nil {
+			bodies[blog.Id] = body
+		}
+	}
+	return models.NewBlogIndex(blogs, bodies, relatedBlogsLimit)
+}
+
+// setBlogIndex replaces the blog index, nil drops it until the next use
+func setBlogIndex(index *models.BlogIndex) *models.BlogIndex {
+	blogIndexMutex.Lock()
+	defer blogIndexMutex.Unlock()
+	blogIndex = index
+	return index
+}
+
+// watchBlogs rebuilds the blog index whenever the blog cache of a JSON repository is reloaded
+func watchBlogs(r repository.ContentRepository) {
+	setBlogIndex(nil)
+	if r, ok := r.(*repository.JSONRepository); ok {
+		r.Blogs.SetOnLoad(func(blogs []models.Blog) {
+			setBlogIndex(buildBlogIndex(blogs))
+		})
+	}
+}
@@ -17,8 +17,10 @@ func (p *BlogProviderImpl) GetAllBlogs() ([]preprocessor.Blog, error) {
 	result := make([]preprocessor.Blog, len(blogs))
 	for i, blog := range blogs {
 		result[i] = preprocessor.Blog{
-			Id:    blog.Id,
-			Title: blog.Title,
+			Id:            blog.Id,
+			Title:         blog.Title,
+			Tags:          blog.Tags,
+			PublishedDate: blog.PublishedDate,
 		}
 	}
 
@@ -0,0 +1,28 @@
+package parser
+
+import (
+	"aHobeychi/personal-website/internal/cache"
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// SetFavoriteDisableCache allows toggling the caching mechanism on or off
+func SetFavoriteDisableCache(flag bool) {
+	if r, ok := jsonRepository(); ok {
+		r.Favorites.SetDisabled(flag)
+	}
+}
+
+// ParseFavorites retrieves the reading list from the content repository, newest first
+// Optional limit parameter controls the maximum number of favorites returned
+// Returns a slice of Favorite models and any error encountered
+func ParseFavorites(limit ...int) ([]models.Favorite, error) {
+	favorites, err := Repository().ListFavorites()
+	return applyLimit(newestFirst(favorites, models.CompareFavorites), err, limit...)
+}
+
+// QueryFavorites starts a query over the reading list, newest first unless another order is set
+func QueryFavorites() *cache.Query[models.Favorite] {
+	return cache.NewQuery(func() ([]models.Favorite, error) {
+		return ParseFavorites()
+	})
+}
@@ -2,30 +2,90 @@ package parser
 
 import (
 	"aHobeychi/personal-website/internal/cache"
-	"aHobeychi/personal-website/internal/config"
 	models "aHobeychi/personal-website/internal/domain"
-	"time"
+	"aHobeychi/personal-website/internal/repometa"
+	"slices"
+	"sync"
 )
 
-var projectCache *cache.Cache[models.Project]
+var (
+	repositoryMetadata      *repometa.Enricher
+	repositoryMetadataMutex sync.RWMutex
+)
+
+// SetRepositoryMetadata sets where the code host metadata of the project links is looked up
+// Projects are served without metadata while none is set
+func SetRepositoryMetadata(e *repometa.Enricher) {
+	repositoryMetadataMutex.Lock()
+	defer repositoryMetadataMutex.Unlock()
+	repositoryMetadata = e
+}
 
-func init() {
-	// Initialize the project cache
-	projectCache = cache.NewCache[models.Project](
-		config.Get().Paths.ProjectsJSON,
-		time.Duration(config.Get().Features.CacheTTL*int(time.Minute)),
-		"project",
-	)
+// withRepositoryMetadata returns a copy of projects with the known metadata of their repositories
+func withRepositoryMetadata(projects []models.Project) []models.Project {
+	repositoryMetadataMutex.RLock()
+	e := repositoryMetadata
+	repositoryMetadataMutex.RUnlock()
+	if e == nil {
+		return projects
+	}
+
+	enriched := slices.Clone(projects)
+	for i, project := range enriched {
+		if metadata, ok := e.Lookup(project.Link); ok {
+			enriched[i].Repository = &metadata
+		}
+	}
+	return enriched
 }
 
 // SetDisableCache allows toggling the caching mechanism on or off
 func SetDisableCache(flag bool) {
-	projectCache.SetDisabled(flag)
+	if r, ok := jsonRepository(); ok {
+		r.Projects.SetDisabled(flag)
+	}
 }
 
-// ParseProjects retrieves a list of projects, either from cache or from file
+// ParseProjects retrieves a list of projects from the content repository
 // Optional limit parameter controls the maximum number of projects returned
 // Returns a slice of Project models and any error encountered
 func ParseProjects(limit ...int) ([]models.Project, error) {
-	return projectCache.Get(limit...)
+	projects, err := Repository().ListProjects()
+	return applyLimit(withProjectRevisionDates(withRepositoryMetadata(projects)), err, limit...)
+}
+
+// QueryProjects starts a query over every project, in catalog order unless another order is set
+func QueryProjects() *cache.Query[models.Project] {
+	return cache.NewQuery(func() ([]models.Project, error) {
+		return ParseProjects()
+	})
+}
+
+// GetProjectBySlug returns the project with the given slug, or os.ErrNotExist
+func GetProjectBySlug(slug string) (models.Project, error) {
+	project, err := Repository().GetProject(slug)
+	if err != nil {
+		return project, err
+	}
+	return withRepositoryMetadata([]models.Project{project})[0], nil
+}
+
+// GetProjectHTMLContent returns the HTML write-up of a project, or os.ErrNotExist when it has none
+func GetProjectHTMLContent(slug string) (string, error) {
+	return Repository().GetProjectHTML(slug)
+}
+
+// ProjectLinks returns the links of every project, for the repository metadata job
+func ProjectLinks() ([]string, error) {
+	projects, err := Repository().ListProjects()
+	if err != nil {
+		return nil, err
+	}
+	links := make([]string, 0, len(projects))
+	for _, project := range projects {
+		if project.Link != "" {
+			links = append(links, project.Link)
+		}
+	}
+	return links, nil
 }
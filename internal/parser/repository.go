@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	"aHobeychi/personal-website/internal/repository"
+	"slices"
+	"sync"
+)
+
+var (
+	contentRepository repository.ContentRepository
+	repositoryMutex   sync.RWMutex
+)
+
+// SetRepository replaces the content repository the parsers read from
+func SetRepository(r repository.ContentRepository) {
+	repositoryMutex.Lock()
+	defer repositoryMutex.Unlock()
+	contentRepository = r
+	watchBlogs(r)
+}
+
+// Repository returns the active content repository
+// Falls back to the JSON file backend when none has been set
+func Repository() repository.ContentRepository {
+	repositoryMutex.RLock()
+	r := contentRepository
+	repositoryMutex.RUnlock()
+	if r != nil {
+		return r
+	}
+
+	repositoryMutex.Lock()
+	defer repositoryMutex.Unlock()
+	if contentRepository == nil {
+		contentRepository = repository.NewJSONRepository(config.Get())
+		watchBlogs(contentRepository)
+	}
+	return contentRepository
+}
+
+// jsonRepository returns the active repository when it is backed by JSON files
+func jsonRepository() (*repository.JSONRepository, bool) {
+	r, ok := Repository().(*repository.JSONRepository)
+	return r, ok
+}
+
+// applyLimit truncates items to the optional limit
+func applyLimit[T any](items []T, err error, limit ...int) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(limit) > 0 && limit[0] < len(items) {
+		return items[:limit[0]], nil
+	}
+	return items, nil
+}
+
+// newestFirst returns a copy of items sorted with cmp, leaving the repository's slice untouched
+// The sort is stable so entries sharing a date keep their catalog order
+func newestFirst[T any](items []T, cmp func(a, b T) int) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, cmp)
+	return sorted
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/githistory"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+var (
+	revisionHistory      *githistory.History
+	revisionHistoryMutex sync.RWMutex
+)
+
+// SetRevisionHistory sets where the revisions of the content are looked up
+// Content is served without revision history while none is set
+func SetRevisionHistory(h *githistory.History) {
+	revisionHistoryMutex.Lock()
+	defer revisionHistoryMutex.Unlock()
+	revisionHistory = h
+}
+
+// currentRevisionHistory returns the revision history, nil while none is set
+func currentRevisionHistory() *githistory.History {
+	revisionHistoryMutex.RLock()
+	defer revisionHistoryMutex.RUnlock()
+	return revisionHistory
+}
+
+// blogSourceRevisions returns the commits that changed the Markdown source of a blog
+func blogSourceRevisions(h *githistory.History, id string) models.Revisions {
+	return h.File(filepath.Join(config.Get().Paths.BlogMarkdown, id+".md"))
+}
+
+// GetBlogRevisions returns the commits that changed the Markdown source or the catalog entry of a blog, newest first
+func GetBlogRevisions(id string) models.Revisions {
+	h := currentRevisionHistory()
+	if h == nil {
+		return nil
+	}
+	return models.MergeRevisions(blogSourceRevisions(h, id), h.Entry(config.Get().Paths.BlogsJSON, id))
+}
+
+// GetProjectRevisions returns the commits that changed the Markdown write-up or the catalog entry of a project, newest first
+func GetProjectRevisions(slug string) models.Revisions {
+	h := currentRevisionHistory()
+	if h == nil {
+		return nil
+	}
+	c := config.Get()
+	return models.MergeRevisions(h.File(filepath.Join(c.Paths.ProjectMarkdown, slug+".md")), h.Entry(c.Paths.ProjectsJSON, slug))
+}
+
+// withProjectRevisionDates returns a copy of projects with the day their catalog entry was first committed
+func withProjectRevisionDates(projects []models.Project) []models.Project {
+	h := currentRevisionHistory()
+	if h == nil {
+		return projects
+	}
+
+	dated := slices.Clone(projects)
+	for i, project := range dated {
+		if revisions := h.Entry(config.Get().Paths.ProjectsJSON, project.ID()); len(revisions) > 0 {
+			dated[i].AddedDate = revisions[len(revisions)-1].Date()
+		}
+	}
+	return dated
+}
+
+// withRevisionDates returns a copy of blogs whose updated date, when not set in the catalog,
+// is the last commit revising their Markdown source after it was first added
+func withRevisionDates(blogs []models.Blog) []models.Blog {
+	h := currentRevisionHistory()
+	if h == nil {
+		return blogs
+	}
+
+	dated := slices.Clone(blogs)
+	for i, blog := range dated {
+		if !blog.UpdatedDate.IsOpen() {
+			continue
+		}
+		if revisions := blogSourceRevisions(h, blog.Id); len(revisions) > 1 {
+			dated[i].UpdatedDate = revisions[0].Date()
+		}
+	}
+	return dated
+}
@@ -2,30 +2,27 @@ package parser
 
 import (
 	"aHobeychi/personal-website/internal/cache"
-	"aHobeychi/personal-website/internal/config"
 	models "aHobeychi/personal-website/internal/domain"
-	"time"
 )
 
-var workExperienceCache *cache.Cache[models.WorkExperience]
-
-func init() {
-	// Initialize the work experience cache
-	workExperienceCache = cache.NewCache[models.WorkExperience](
-		config.Get().Paths.WorkExperienceJSON,
-		time.Duration(config.Get().Features.CacheTTL*int(time.Minute)),
-		"work experience",
-	)
-}
-
 // SetWorkExperienceDisableCache allows toggling the caching mechanism on or off
 func SetWorkExperienceDisableCache(flag bool) {
-	workExperienceCache.SetDisabled(flag)
+	if r, ok := jsonRepository(); ok {
+		r.WorkExperiences.SetDisabled(flag)
+	}
 }
 
-// ParseWorkExperiences retrieves a list of work experiences, either from cache or from file
+// ParseWorkExperiences retrieves a list of work experiences from the content repository, newest first
 // Optional limit parameter controls the maximum number of work experiences returned
 // Returns a slice of WorkExperience models and any error encountered
 func ParseWorkExperiences(limit ...int) ([]models.WorkExperience, error) {
-	return workExperienceCache.Get(limit...)
+	experiences, err := Repository().ListWorkExperiences()
+	return applyLimit(newestFirst(experiences, models.CompareWorkExperiences), err, limit...)
+}
+
+// QueryWorkExperiences starts a query over every work experience, newest first unless another order is set
+func QueryWorkExperiences() *cache.Query[models.WorkExperience] {
+	return cache.NewQuery(func() ([]models.WorkExperience, error) {
+		return ParseWorkExperiences()
+	})
 }
@@ -0,0 +1,336 @@
+package preprocessor
+
+import (
+	"aHobeychi/personal-website/internal/config"
+	"aHobeychi/personal-website/internal/util/locale"
+	"aHobeychi/personal-website/internal/util/logger"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Size of the Open Graph preview images, the ratio recommended by most link previews
+const (
+	OGImageWidth  = 1200
+	OGImageHeight = 630
+)
+
+// Layout of the preview images, in pixels
+const (
+	ogMargin        = 80
+	ogAccentWidth   = 16
+	ogTitleSize     = 64
+	ogTitleLeading  = 80
+	ogTitleMaxLines = 4
+	ogDetailSize    = 30
+)
+
+// OGImageStyle holds the colors of the generated preview images
+type OGImageStyle struct {
+	Background color.RGBA
+	Foreground color.RGBA
+	Accent     color.RGBA
+}
+
+// DefaultOGImageStyle is used for every color missing or invalid in the configuration
+var DefaultOGImageStyle = OGImageStyle{
+	Background: color.RGBA{R: 0x11, G: 0x18, B: 0x27, A: 0xff},
+	Foreground: color.RGBA{R: 0xf9, G: 0xfa, B: 0xfb, A: 0xff},
+	Accent:     color.RGBA{R: 0x60, G: 0xa5, B: 0xfa, A: 0xff},
+}
+
+// OGImageStyleFromConfig reads the preview image colors from Site.OGImage
+func OGImageStyleFromConfig(c *config.Config) OGImageStyle {
+	style := DefaultOGImageStyle
+	style.Background = parseHexColorOr(c.Site.OGImage.Background, style.Background)
+	style.Foreground = parseHexColorOr(c.Site.OGImage.Foreground, style.Foreground)
+	style.Accent = parseHexColorOr(c.Site.OGImage.Accent, style.Accent)
+	return style
+}
+
+// parseHexColorOr parses a "#rrggbb" color, returning fallback when the value is empty or invalid
+func parseHexColorOr(value string, fallback color.RGBA) color.RGBA {
+	if value == "" {
+		return fallback
+	}
+
+	var c color.RGBA
+	if _, err := fmt.Sscanf(value, "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil || len(value) != 7 {
+		logger.LogWarning("Invalid preview image color " + value + ", using the default")
+		return fallback
+	}
+	c.A = 0xff
+	return c
+}
+
+// RenderOGImage draws the preview image of a blog post: its title, publish date and tags
+func RenderOGImage(blog Blog, style OGImageStyle) (*image.RGBA, error) {
+	titleFace, err := newFace(gobold.TTF, ogTitleSize)
+	if err != nil {
+		return nil, err
+	}
+	defer titleFace.Close()
+
+	detailFace, err := newFace(goregular.TTF, ogDetailSize)
+	if err != nil {
+		return nil, err
+	}
+	defer detailFace.Close()
+
+	img := image.NewRGBA(image.Rect(0, 0, OGImageWidth, OGImageHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(style.Background), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, ogAccentWidth, OGImageHeight), image.NewUniform(style.Accent), image.Point{}, draw.Src)
+
+	textWidth := OGImageWidth - 2*ogMargin
+
+	// Site name at the top
+	drawText(img, detailFace, style.Accent, ogMargin, ogMargin+ogDetailSize, siteName())
+
+	// Title, wrapped and vertically centered
+	lines := wrapText(titleFace, blog.Title, textWidth, ogTitleMaxLines)
+	y := (OGImageHeight-len(lines)*ogTitleLeading)/2 + ogTitleSize
+	for _, line := range lines {
+		drawText(img, titleFace, style.Foreground, ogMargin, y, line)
+		y += ogTitleLeading
+	}
+
+	// Date and tags at the bottom
+	details := blogDetails(blog)
+	if details != "" {
+		lines := wrapText(detailFace, details, textWidth, 1)
+		drawText(img, detailFace, muted(style.Foreground, style.Background), ogMargin, OGImageHeight-ogMargin, lines[0])
+	}
+
+	return img, nil
+}
+
+// newFace loads one of the bundled Go fonts at the given size in pixels
+func newFace(ttf []byte, size float64) (font.Face, error) {
+	f, err := opentype.Parse(ttf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse font: %w", err)
+	}
+	return opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+}
+
+// drawText draws a single line of text with its baseline at y
+func drawText(img draw.Image, face font.Face, c color.Color, x int, y int, text string) {
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+// wrapText splits text into lines no wider than width, ending the last line with an ellipsis
+// when the text needs more than maxLines lines
+func wrapText(face font.Face, text string, width int, maxLines int) []string {
+	maxWidth := fixed.I(width)
+	fits := func(s string) bool { return font.MeasureString(face, s) <= maxWidth }
+
+	var lines []string
+	var current string
+	words := strings.Fields(text)
+	for i, word := range words {
+		candidate := strings.TrimSpace(current + " " + word)
+		if current == "" || fits(candidate) {
+			current = candidate
+			continue
+		}
+
+		lines = append(lines, current)
+		current = word
+		if len(lines) == maxLines {
+			// The remaining words do not fit, shorten the last line to make room for the ellipsis
+			last := strings.Join(append([]string{lines[maxLines-1]}, words[i:]...), " ")
+			lines[maxLines-1] = truncate(face, last, maxWidth)
+			return lines
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "")
+	}
+
+	// A single word longer than the line is cut as well
+	for i, line := range lines {
+		if !fits(line) {
+			lines[i] = truncate(face, line, maxWidth)
+		}
+	}
+	return lines
+}
+
+// truncate drops words, or runes for a single long word, until text fits in maxWidth with a trailing ellipsis
+func truncate(face font.Face, text string, maxWidth fixed.Int26_6) string {
+	const ellipsis = "…"
+	fits := func(s string) bool { return font.MeasureString(face, s) <= maxWidth }
+	if fits(text) {
+		return text
+	}
+
+	words := strings.Fields(text)
+	for len(words) > 1 {
+		words = words[:len(words)-1]
+		if candidate := strings.TrimRight(strings.Join(words, " "), " ,·") + ellipsis; fits(candidate) {
+			return candidate
+		}
+	}
+
+	runes := []rune(strings.Join(words, ""))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if candidate := string(runes) + ellipsis; fits(candidate) {
+			return candidate
+		}
+	}
+	return ellipsis
+}
+
+// blogDetails formats the publish date and tags shown under the title
+func blogDetails(blog Blog) string {
+	var parts []string
+	if !blog.PublishedDate.IsOpen() {
+		lang := config.Get().Site.Locale
+		if blog.PublishedDate.MonthOnly {
+			parts = append(parts, locale.FormatMonth(blog.PublishedDate.Time, lang))
+		} else {
+			parts = append(parts, locale.FormatDay(blog.PublishedDate.Time, lang))
+		}
+	}
+	if len(blog.Tags) > 0 {
+		parts = append(parts, strings.Join(blog.Tags, ", "))
+	}
+	return strings.Join(parts, "  ·  ")
+}
+
+// siteName returns the name printed at the top of the preview images, the one of the page titles
+func siteName() string {
+	return config.Get().Site.Title
+}
+
+// muted blends the foreground into the background for secondary text
+func muted(foreground color.RGBA, background color.RGBA) color.RGBA {
+	blend := func(a, b uint8) uint8 { return uint8((int(a)*2 + int(b)) / 3) }
+	return color.RGBA{
+		R: blend(foreground.R, background.R),
+		G: blend(foreground.G, background.G),
+		B: blend(foreground.B, background.B),
+		A: 0xff,
+	}
+}
+
+// GetBlogOGImagePath returns where the preview image of a blog is stored
+func GetBlogOGImagePath(blogId string) string {
+	return filepath.Join(config.Get().Paths.OGImages, blogId+".png")
+}
+
+// GenerateAndSaveOGImage renders the preview image of a blog and writes it to disk
+func GenerateAndSaveOGImage(blog Blog) error {
+	img, err := RenderOGImage(blog, OGImageStyleFromConfig(config.Get()))
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(config.Get().Paths.OGImages, 0755); err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(GetBlogOGImagePath(blog.Id), buffer.Bytes()); err != nil {
+		logger.ErrorLogger.Printf("Error writing preview image for blog ID %s: %v", blog.Id, err)
+		return err
+	}
+
+	logger.DebugLogger.Printf("Generated and saved preview image for blog ID %s", blog.Id)
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it into place,
+// so readers never see a partially written file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// GenerateAllOGImages generates the preview image of every blog
+func GenerateAllOGImages(provider BlogProvider) error {
+	blogs, err := provider.GetAllBlogs()
+	if err != nil {
+		return err
+	}
+
+	for _, blog := range blogs {
+		if err := GenerateAndSaveOGImage(blog); err != nil {
+			logger.ErrorLogger.Printf("Error generating preview image for blog ID %s: %v", blog.Id, err)
+		}
+	}
+
+	logger.DebugLogger.Println("Generated preview images for all blogs")
+	return nil
+}
+
+// Serialises the lazy generation of preview images so concurrent requests render a missing image once
+var ogImageMutex sync.Mutex
+
+// GetBlogOGImage returns the path of the preview image of a blog, generating it if it does not exist yet
+func GetBlogOGImage(blogId string, provider BlogProvider) (string, error) {
+	imagePath := GetBlogOGImagePath(blogId)
+
+	ogImageMutex.Lock()
+	defer ogImageMutex.Unlock()
+
+	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
+		logger.DebugLogger.Printf("Preview image for blog ID %s does not exist, generating it", blogId)
+
+		blogs, err := provider.GetAllBlogs()
+		if err != nil {
+			return "", err
+		}
+
+		for _, blog := range blogs {
+			if blog.Id == blogId {
+				return imagePath, GenerateAndSaveOGImage(blog)
+			}
+		}
+		return "", fmt.Errorf("blog with ID %s not found: %w", blogId, os.ErrNotExist)
+	}
+
+	return imagePath, nil
+}
@@ -0,0 +1,66 @@
+package preprocessor
+
+import (
+	models "aHobeychi/personal-website/internal/domain"
+	"image/color"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/font/gofont/gobold"
+)
+
+func TestRenderOGImage(t *testing.T) {
+	blog := Blog{
+		Id:            "post",
+		Title:         "Building my Personal Website With Go, Htmx and TailwindCSS",
+		Tags:          []string{"Go", "Htmx"},
+		PublishedDate: models.MustParseDate("2025-04-20"),
+	}
+
+	img, err := RenderOGImage(blog, DefaultOGImageStyle)
+	if err != nil {
+		t.Fatalf("RenderOGImage() error = %v", err)
+	}
+
+	if bounds := img.Bounds(); bounds.Dx() != OGImageWidth || bounds.Dy() != OGImageHeight {
+		t.Errorf("RenderOGImage() size = %dx%d, want %dx%d", bounds.Dx(), bounds.Dy(), OGImageWidth, OGImageHeight)
+	}
+	if got := img.RGBAAt(OGImageWidth-1, OGImageHeight-1); got != DefaultOGImageStyle.Background {
+		t.Errorf("corner color = %v, want the background %v", got, DefaultOGImageStyle.Background)
+	}
+	if got := img.RGBAAt(0, 0); got != DefaultOGImageStyle.Accent {
+		t.Errorf("accent bar color = %v, want %v", got, DefaultOGImageStyle.Accent)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	face, err := newFace(gobold.TTF, ogTitleSize)
+	if err != nil {
+		t.Fatalf("newFace() error = %v", err)
+	}
+	defer face.Close()
+
+	lines := wrapText(face, strings.Repeat("word ", 100), 1040, 3)
+	if len(lines) != 3 {
+		t.Fatalf("wrapText() returned %d lines, want 3", len(lines))
+	}
+	if !strings.HasSuffix(lines[2], "…") {
+		t.Errorf("last line = %q, want it to end with an ellipsis", lines[2])
+	}
+
+	if lines := wrapText(face, "Short title", 1040, 3); len(lines) != 1 || lines[0] != "Short title" {
+		t.Errorf("wrapText() = %q, want a single unchanged line", lines)
+	}
+}
+
+func TestParseHexColorOr(t *testing.T) {
+	fallback := color.RGBA{A: 0xff}
+	if got := parseHexColorOr("#60a5fa", fallback); got != (color.RGBA{R: 0x60, G: 0xa5, B: 0xfa, A: 0xff}) {
+		t.Errorf("parseHexColorOr(#60a5fa) = %v", got)
+	}
+	for _, invalid := range []string{"", "blue", "#fff", "#60a5fa00"} {
+		if got := parseHexColorOr(invalid, fallback); got != fallback {
+			t.Errorf("parseHexColorOr(%q) = %v, want the fallback", invalid, got)
+		}
+	}
+}
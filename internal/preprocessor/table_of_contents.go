@@ -3,6 +3,7 @@ package preprocessor
 
 import (
 	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
 	"aHobeychi/personal-website/internal/util/logger"
 	"bytes"
 	"fmt"
@@ -11,10 +12,12 @@ import (
 	"strings"
 )
 
-// Blog represents the basic structure needed for table of contents generation
+// Blog represents the basic structure needed for table of contents and preview image generation
 type Blog struct {
-	Id    string
-	Title string
+	Id            string
+	Title         string
+	Tags          []string
+	PublishedDate models.Date
 }
 
 // BlogProvider is an interface for retrieving blog information
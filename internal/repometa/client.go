@@ -0,0 +1,138 @@
+// Package repometa enriches projects with the metadata of the code repositories they link to
+package repometa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// DefaultGitHubBaseURL is the GitHub REST API used when no base URL is configured
+const DefaultGitHubBaseURL = "https://api.github.com"
+
+// Client fetches repository metadata from a code host
+type Client interface {
+	// RepositoryName returns the name of the repository a link points to, e.g. "owner/name"
+	// ok is false when the link does not point to a repository of this code host
+	RepositoryName(link string) (name string, ok bool)
+	// Fetch retrieves the metadata of a repository named by RepositoryName
+	Fetch(ctx context.Context, name string) (models.RepositoryMetadata, error)
+}
+
+// GitHubClient reads repository metadata from the GitHub REST API
+type GitHubClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewGitHubClient creates a client for the GitHub API at baseURL, or DefaultGitHubBaseURL when empty
+// The token is optional and raises the API rate limit when set
+func NewGitHubClient(baseURL string, token string, timeout time.Duration) *GitHubClient {
+	if baseURL == "" {
+		baseURL = DefaultGitHubBaseURL
+	}
+	return &GitHubClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// RepositoryName returns "owner/name" for links such as https://github.com/owner/name/tree/main
+func (c *GitHubClient) RepositoryName(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	if host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www."); host != "github.com" {
+		return "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"), true
+}
+
+// githubRepository is the subset of the GitHub repository resource read by the client
+type githubRepository struct {
+	FullName        string    `json:"full_name"`
+	StargazersCount int       `json:"stargazers_count"`
+	Language        string    `json:"language"`
+	CreatedAt       time.Time `json:"created_at"`
+	PushedAt        time.Time `json:"pushed_at"`
+	Archived        bool      `json:"archived"`
+}
+
+// githubCommit is the subset of the GitHub commit resource read by the client
+type githubCommit struct {
+	Commit struct {
+		Committer struct {
+			Date time.Time `json:"date"`
+		} `json:"committer"`
+	} `json:"commit"`
+}
+
+// Fetch retrieves the stars, primary language, creation and latest commit of a repository
+// The date of the last push is used when the latest commit cannot be read, e.g. for an empty repository
+func (c *GitHubClient) Fetch(ctx context.Context, name string) (models.RepositoryMetadata, error) {
+	var repository githubRepository
+	if err := c.get(ctx, "/repos/"+name, &repository); err != nil {
+		return models.RepositoryMetadata{}, err
+	}
+
+	lastCommit := repository.PushedAt
+	var commits []githubCommit
+	if err := c.get(ctx, "/repos/"+name+"/commits?per_page=1", &commits); err == nil && len(commits) > 0 {
+		lastCommit = commits[0].Commit.Committer.Date
+	}
+
+	metadata := models.RepositoryMetadata{
+		FullName: repository.FullName,
+		Stars:    repository.StargazersCount,
+		Language: repository.Language,
+		Archived: repository.Archived,
+	}
+	if !repository.CreatedAt.IsZero() {
+		metadata.Created = models.Date{Time: repository.CreatedAt.UTC().Truncate(24 * time.Hour)}
+	}
+	if !lastCommit.IsZero() {
+		metadata.LastCommit = models.Date{Time: lastCommit.UTC().Truncate(24 * time.Hour)}
+	}
+	return metadata, nil
+}
+
+// get decodes the JSON response of a GET request to the API
+func (c *GitHubClient) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "personal-website")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("GET %s: invalid response: %w", path, err)
+	}
+	return nil
+}
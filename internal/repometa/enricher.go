@@ -0,0 +1,86 @@
+package repometa
+
+import (
+	"context"
+	"time"
+
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/util/logger"
+)
+
+// Enricher refreshes the metadata of the repositories linked from projects and serves it from its store
+type Enricher struct {
+	client Client
+	store  *Store
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewEnricher creates an enricher fetching through client, refetching entries older than ttl
+func NewEnricher(client Client, store *Store, ttl time.Duration) *Enricher {
+	return &Enricher{client: client, store: store, ttl: ttl, now: time.Now}
+}
+
+// Lookup returns the last known metadata of the repository a link points to, however old
+func (e *Enricher) Lookup(link string) (models.RepositoryMetadata, bool) {
+	name, ok := e.client.RepositoryName(link)
+	if !ok {
+		return models.RepositoryMetadata{}, false
+	}
+	entry, ok := e.store.Get(name)
+	return entry.Metadata, ok
+}
+
+// Refresh fetches the metadata of every linked repository missing from the store or older than the TTL
+// A failed fetch keeps the previous entry so the site degrades to stale values, or none, while offline
+// Returns the number of repositories fetched
+func (e *Enricher) Refresh(ctx context.Context, links []string) int {
+	fetched := 0
+	seen := map[string]bool{}
+	for _, link := range links {
+		name, ok := e.client.RepositoryName(link)
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if entry, ok := e.store.Get(name); ok && !entry.IsStale(e.now(), e.ttl) {
+			continue
+		}
+
+		metadata, err := e.client.Fetch(ctx, name)
+		if err != nil {
+			logger.LogWarning("Failed to fetch repository metadata of " + name + ": " + err.Error())
+			continue
+		}
+		e.store.Put(name, metadata, e.now())
+		fetched++
+	}
+
+	if fetched > 0 {
+		if err := e.store.Save(); err != nil {
+			logger.LogWarning("Failed to save repository metadata: " + err.Error())
+		}
+	}
+	return fetched
+}
+
+// Run refreshes the repositories returned by links right away, then every interval until ctx is done
+func (e *Enricher) Run(ctx context.Context, interval time.Duration, links func() ([]string, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if current, err := links(); err != nil {
+			logger.LogWarning("Failed to list project links: " + err.Error())
+		} else {
+			e.Refresh(ctx, current)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package repometa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newGitHubStandIn serves the repository and commit resources of owner/site and counts the requests
+func newGitHubStandIn(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/site", func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Authorization = %q, want the token", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"full_name": "owner/site", "stargazers_count": 42, "language": "Go", "created_at": "2023-03-14T09:00:00Z", "pushed_at": "2025-05-01T10:00:00Z", "archived": false}`))
+	})
+	mux.HandleFunc("GET /repos/owner/site/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"commit": {"committer": {"date": "2025-04-28T18:30:00Z"}}}]`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitHubClient(t *testing.T) {
+	requests := 0
+	server := newGitHubStandIn(t, &requests)
+	client := NewGitHubClient(server.URL, "secret", time.Second)
+
+	tests := []struct {
+		link string
+		want string
+		ok   bool
+	}{
+		{"https://github.com/owner/site", "owner/site", true},
+		{"https://www.github.com/owner/site.git", "owner/site", true},
+		{"https://github.com/owner/site/tree/main/docs", "owner/site", true},
+		{"https://github.com/owner", "", false},
+		{"https://www.allcaughtup.tech", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := client.RepositoryName(tt.link); got != tt.want || ok != tt.ok {
+			t.Errorf("RepositoryName(%q) = %q, %v, want %q, %v", tt.link, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	metadata, err := client.Fetch(context.Background(), "owner/site")
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if metadata.Stars != 42 || metadata.Language != "Go" || metadata.Created.String() != "2023-03-14" || metadata.LastCommit.String() != "2025-04-28" {
+		t.Errorf("Fetch() = %+v, want 42 stars, Go, the creation and the latest commit date", metadata)
+	}
+
+	if _, err := client.Fetch(context.Background(), "owner/missing"); err == nil {
+		t.Error("Fetch() of a missing repository error = nil")
+	}
+}
+
+func TestEnricherRefresh(t *testing.T) {
+	requests := 0
+	server := newGitHubStandIn(t, &requests)
+	path := filepath.Join(t.TempDir(), "repositories.json")
+	links := []string{"https://github.com/owner/site", "https://github.com/owner/site/", "https://example.com"}
+
+	store, _ := OpenStore(path)
+	enricher := NewEnricher(NewGitHubClient(server.URL, "secret", time.Second), store, time.Hour)
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	enricher.now = func() time.Time { return now }
+
+	if fetched := enricher.Refresh(context.Background(), links); fetched != 1 || requests != 1 {
+		t.Fatalf("Refresh() fetched %d repositories with %d requests, want 1 each", fetched, requests)
+	}
+	if fetched := enricher.Refresh(context.Background(), links); fetched != 0 {
+		t.Errorf("Refresh() within the TTL fetched %d repositories, want 0", fetched)
+	}
+
+	// Once the TTL is over with the code host unreachable, the stale entry is still served
+	server.Close()
+	now = now.Add(2 * time.Hour)
+	if fetched := enricher.Refresh(context.Background(), links); fetched != 0 {
+		t.Errorf("Refresh() while offline fetched %d repositories, want 0", fetched)
+	}
+	if metadata, ok := enricher.Lookup("https://github.com/owner/site"); !ok || metadata.Stars != 42 {
+		t.Errorf("Lookup() while offline = %+v, %v, want the stale metadata", metadata, ok)
+	}
+
+	// The saved entries survive a restart
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+	if entry, ok := reopened.Get("owner/site"); !ok || entry.Metadata.FullName != "owner/site" || !entry.FetchedAt.Equal(now.Add(-2*time.Hour)) {
+		t.Errorf("reopened entry = %+v, %v", entry, ok)
+	}
+}
@@ -0,0 +1,93 @@
+package repometa
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// Entry is the metadata of a repository along with when it was fetched
+type Entry struct {
+	Metadata  models.RepositoryMetadata `json:"metadata"`
+	FetchedAt time.Time                 `json:"fetchedAt"`
+}
+
+// IsStale reports whether the entry is older than ttl at now
+func (e Entry) IsStale(now time.Time, ttl time.Duration) bool {
+	return !now.Before(e.FetchedAt.Add(ttl))
+}
+
+// Store keeps the fetched metadata in memory and persists it to a JSON file keyed by repository name
+// so that restarts and offline periods still have the last known values
+type Store struct {
+	path    string
+	mutex   sync.RWMutex
+	entries map[string]Entry
+}
+
+// OpenStore loads the store saved at path
+// A missing file opens an empty store, an unreadable one returns an empty store along with the error
+// An empty path keeps the entries in memory only
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path, entries: map[string]Entry{}}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s.entries); err != nil {
+		s.entries = map[string]Entry{}
+		return s, fmt.Errorf("invalid repository metadata cache %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Get returns the entry of a repository
+func (s *Store) Get(name string) (Entry, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	entry, ok := s.entries[name]
+	return entry, ok
+}
+
+// Put records the metadata of a repository fetched at fetchedAt
+func (s *Store) Put(name string, metadata models.RepositoryMetadata, fetchedAt time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.entries[name] = Entry{Metadata: metadata, FetchedAt: fetchedAt}
+}
+
+// Save writes the entries to the store file, replacing it atomically
+func (s *Store) Save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	s.mutex.RLock()
+	data, err := json.MarshalIndent(s.entries, "", "  ")
+	s.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/util/logger"
+)
+
+// ImportStats reports how many rows of each content type were imported
+type ImportStats struct {
+	Blogs           int
+	Projects        int
+	WorkExperiences int
+	Certifications  int
+	Favorites       int
+}
+
+// Import replaces the database content with everything available in source
+// The whole import runs in a single transaction so readers never observe a partial catalog
+func (r *SQLiteRepository) Import(source ContentRepository) (ImportStats, error) {
+	var stats ImportStats
+
+	blogs, err := source.ListBlogs()
+	if err != nil {
+		return stats, fmt.Errorf("failed to load blogs: %w", err)
+	}
+	projects, err := source.ListProjects()
+	if err != nil {
+		return stats, fmt.Errorf("failed to load projects: %w", err)
+	}
+	experiences, err := source.ListWorkExperiences()
+	if err != nil {
+		return stats, fmt.Errorf("failed to load work experiences: %w", err)
+	}
+	certifications, err := source.ListCertifications()
+	if err != nil {
+		return stats, fmt.Errorf("failed to load certifications: %w", err)
+	}
+	favorites, err := source.ListFavorites()
+	if err != nil {
+		return stats, fmt.Errorf("failed to load favorites: %w", err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return stats, err
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"blog_tags", "blogs", "projects", "work_experiences", "certifications", "favorites"} {
+		if _, err := tx.Exec(`DELETE FROM ` + table); err != nil {
+			return stats, fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+
+	for i, blog := range blogs {
+		if err := importBlog(tx, source, i, blog); err != nil {
+			return stats, fmt.Errorf("failed to import blog %s: %w", blog.Id, err)
+		}
+		stats.Blogs++
+	}
+
+	for i, project := range projects {
+		// Write-ups are optional, a project without one is stored with an empty body
+		html, err := source.GetProjectHTML(project.ID())
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.LogWarning(fmt.Sprintf("Cannot read the write-up of project %s: %v", project.ID(), err))
+		}
+
+		_, err = tx.Exec(`INSERT INTO projects (position, name, description, link, tags, slug, status, role, start_date, end_date, screenshots, html)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			i, project.Name, project.Description, project.Link, encodeTags(project.Tags),
+			project.ID(), project.Status, project.Role, project.StartDate, project.EndDate, encodeScreenshots(project.Screenshots), html)
+		if err != nil {
+			return stats, fmt.Errorf("failed to import project %s: %w", project.Name, err)
+		}
+		stats.Projects++
+	}
+
+	for i, experience := range experiences {
+		_, err := tx.Exec(`INSERT INTO work_experiences (position, job_title, company_name, description, start_date, end_date, tags,
+			location, remote, employment_type, achievements)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			i, experience.JobTitle, experience.CompanyName, experience.Description,
+			experience.StartDate, experience.EndDate, encodeTags(experience.Tags),
+			experience.Location, experience.Remote, experience.EmploymentType, encodeAchievements(experience.Achievements))
+		if err != nil {
+			return stats, fmt.Errorf("failed to import work experience %s: %w", experience.JobTitle, err)
+		}
+		stats.WorkExperiences++
+	}
+
+	for i, certification := range certifications {
+		_, err := tx.Exec(`INSERT INTO certifications (position, name, issuer, date_received, url, expiry_date, credential_id, logo)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			i, certification.Name, certification.Issuer, certification.DateReceived, certification.Url,
+			certification.ExpiryDate, certification.CredentialID, certification.Logo)
+		if err != nil {
+			return stats, fmt.Errorf("failed to import certification %s: %w", certification.Name, err)
+		}
+		stats.Certifications++
+	}
+
+	for i, favorite := range favorites {
+		_, err := tx.Exec(`INSERT INTO favorites (position, title, url, kind, note, added_date) VALUES (?, ?, ?, ?, ?, ?)`,
+			i, favorite.Title, favorite.Url, favorite.Kind, favorite.Note, favorite.AddedDate)
+		if err != nil {
+			return stats, fmt.Errorf("failed to import favorite %s: %w", favorite.Title, err)
+		}
+		stats.Favorites++
+	}
+
+	return stats, tx.Commit()
+}
+
+// importBlog inserts a blog, its tags, its HTML body, table of contents and Markdown source
+// A missing body, table of contents or source is logged and stored empty rather than aborting the import
+func importBlog(tx *sql.Tx, source ContentRepository, position int, blog models.Blog) error {
+	html, err := source.GetBlogHTML(blog.Id)
+	if err != nil {
+		logger.LogWarning(fmt.Sprintf("No HTML content for blog %s: %v", blog.Id, err))
+	}
+	toc, err := source.GetBlogTableOfContents(blog.Id)
+	if err != nil {
+		logger.LogWarning(fmt.Sprintf("No table of contents for blog %s: %v", blog.Id, err))
+	}
+	markdown, err := source.GetBlogMarkdown(blog.Id)
+	if err != nil {
+		logger.LogWarning(fmt.Sprintf("No markdown source for blog %s: %v", blog.Id, err))
+	}
+
+	_, err = tx.Exec(`INSERT INTO blogs (id, position, title, description, tags, published_date, updated_date, external_link, html, toc, markdown)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		blog.Id, position, blog.Title, blog.Description, encodeTags(blog.Tags),
+		blog.PublishedDate, blog.UpdatedDate, blog.ExternalLink, html, toc, markdown)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range blog.Tags {
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO blog_tags (blog_id, tag) VALUES (?, ?)`, blog.Id, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeAchievements converts achievements into the JSON representation stored in the achievements column
+func encodeAchievements(achievements []models.Achievement) string {
+	if achievements == nil {
+		achievements = []models.Achievement{}
+	}
+	data, _ := json.Marshal(achievements)
+	return string(data)
+}
+
+// encodeScreenshots converts screenshots into the JSON representation stored in the screenshots column
+func encodeScreenshots(screenshots []models.Screenshot) string {
+	if screenshots == nil {
+		screenshots = []models.Screenshot{}
+	}
+	data, _ := json.Marshal(screenshots)
+	return string(data)
+}
+
+// encodeTags converts tags into the JSON representation stored in tag columns
+func encodeTags(tags []string) string {
+	if tags == nil {
+		tags = []string{}
+	}
+	data, _ := json.Marshal(tags)
+	return string(data)
+}
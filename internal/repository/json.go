@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"aHobeychi/personal-website/internal/cache"
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+	"aHobeychi/personal-website/internal/util/logger"
+)
+
+// JSONRepository serves content from the JSON catalogs and the pre-generated HTML files on disk
+// Each catalog is kept in its own cache so they can be toggled independently
+type JSONRepository struct {
+	Blogs           *cache.Cache[models.Blog]
+	Projects        *cache.Cache[models.Project]
+	WorkExperiences *cache.Cache[models.WorkExperience]
+	Certifications  *cache.Cache[models.Certification]
+	Favorites       *cache.Cache[models.Favorite]
+
+	blogHTMLPath     string
+	tocHTMLPath      string
+	blogMarkdownPath string
+	projectHTMLPath  string
+}
+
+// NewJSONRepository creates a repository reading the files configured in Paths
+func NewJSONRepository(c *config.Config) *JSONRepository {
+	ttl := time.Duration(c.Features.CacheTTL * int(time.Minute))
+
+	// Work experience entries may group several roles at one company
+	workExperiences := cache.NewCache[models.WorkExperience](c.Paths.WorkExperienceJSON, ttl, "work experience")
+	workExperiences.SetDecoder(models.DecodeWorkExperiences)
+
+	return &JSONRepository{
+		Blogs:            cache.NewCache[models.Blog](c.Paths.BlogsJSON, ttl, "blog"),
+		Projects:         cache.NewCache[models.Project](c.Paths.ProjectsJSON, ttl, "project"),
+		WorkExperiences:  workExperiences,
+		Certifications:   cache.NewCache[models.Certification](c.Paths.CertificationsJSON, ttl, "certification"),
+		Favorites:        cache.NewCache[models.Favorite](c.Paths.FavoritesJSON, ttl, "favorite"),
+		blogHTMLPath:     c.Paths.BlogHTML,
+		tocHTMLPath:      c.Paths.TocHTML,
+		blogMarkdownPath: c.Paths.BlogMarkdown,
+		projectHTMLPath:  c.Paths.ProjectHTML,
+	}
+}
+
+// ListBlogs returns every blog in the blogs catalog
+func (r *JSONRepository) ListBlogs() ([]models.Blog, error) {
+	return r.Blogs.Get()
+}
+
+// GetBlog returns the blog with the given id
+func (r *JSONRepository) GetBlog(id string) (models.Blog, error) {
+	blogs, err := r.Blogs.Get()
+	if err != nil {
+		return models.Blog{}, err
+	}
+
+	if blog, ok := findBlog(blogs, id); ok {
+		return blog, nil
+	}
+
+	return models.Blog{}, os.ErrNotExist
+}
+
+// GetBlogHTML returns the HTML content of a blog post by its ID
+func (r *JSONRepository) GetBlogHTML(id string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(r.blogHTMLPath, id+".html"))
+	if err != nil {
+		logger.ErrorLogger.Println("Error reading blog content file:", err)
+		return "", err
+	}
+	logger.DebugLogger.Printf("HTML content retrieved for blog ID: %s", id)
+	return string(content), nil
+}
+
+// GetBlogTableOfContents returns the pre-generated table of contents HTML for a blog post
+func (r *JSONRepository) GetBlogTableOfContents(id string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(r.tocHTMLPath, id+"-toc.html"))
+	if err != nil {
+		logger.ErrorLogger.Println("Error reading blog table of contents file:", err)
+		return "", err
+	}
+	logger.DebugLogger.Printf("Table of contents retrieved for blog ID: %s", id)
+	return string(content), nil
+}
+
+// GetBlogMarkdown returns the Markdown source a blog post was generated from
+func (r *JSONRepository) GetBlogMarkdown(id string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(r.blogMarkdownPath, id+".md"))
+	if err != nil {
+		logger.ErrorLogger.Println("Error reading blog markdown file:", err)
+		return "", err
+	}
+	logger.DebugLogger.Printf("Markdown source retrieved for blog ID: %s", id)
+	return string(content), nil
+}
+
+// ListProjects returns every project in the projects catalog
+func (r *JSONRepository) ListProjects() ([]models.Project, error) {
+	return r.Projects.Get()
+}
+
+// GetProject returns the project with the given slug
+func (r *JSONRepository) GetProject(slug string) (models.Project, error) {
+	projects, err := r.Projects.Get()
+	if err != nil {
+		return models.Project{}, err
+	}
+
+	if project, ok := models.FindProject(projects, slug); ok {
+		return project, nil
+	}
+
+	return models.Project{}, os.ErrNotExist
+}
+
+// GetProjectHTML returns the write-up of a project generated from its Markdown, os.ErrNotExist when it has none
+func (r *JSONRepository) GetProjectHTML(slug string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(r.projectHTMLPath, slug+".html"))
+	if err != nil {
+		return "", err
+	}
+	logger.DebugLogger.Printf("HTML write-up retrieved for project: %s", slug)
+	return string(content), nil
+}
+
+// ListWorkExperiences returns every entry in the work experience catalog
+func (r *JSONRepository) ListWorkExperiences() ([]models.WorkExperience, error) {
+	return r.WorkExperiences.Get()
+}
+
+// ListCertifications returns every certification in the certifications catalog
+func (r *JSONRepository) ListCertifications() ([]models.Certification, error) {
+	return r.Certifications.Get()
+}
+
+// ListFavorites returns every entry in the favorites catalog
+func (r *JSONRepository) ListFavorites() ([]models.Favorite, error) {
+	return r.Favorites.Get()
+}
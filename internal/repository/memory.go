@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"os"
+	"slices"
+	"sync"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// MemoryRepository keeps all content in memory, which makes it convenient for tests
+// Blog bodies and table of contents are keyed by blog id
+// Listings are copies, so callers can sort them without changing the stored content
+type MemoryRepository struct {
+	mutex           sync.RWMutex
+	Blogs           []models.Blog
+	BlogHTML        map[string]string
+	BlogTOC         map[string]string
+	BlogMarkdown    map[string]string
+	Projects        []models.Project
+	ProjectHTML     map[string]string
+	WorkExperiences []models.WorkExperience
+	Certifications  []models.Certification
+	Favorites       []models.Favorite
+}
+
+// NewMemoryRepository creates an empty in-memory repository
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		BlogHTML:     map[string]string{},
+		BlogTOC:      map[string]string{},
+		BlogMarkdown: map[string]string{},
+		ProjectHTML:  map[string]string{},
+	}
+}
+
+// AddBlog stores a blog along with its HTML body and table of contents
+func (r *MemoryRepository) AddBlog(blog models.Blog, html string, toc string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.Blogs = append(r.Blogs, blog)
+	r.BlogHTML[blog.Id] = html
+	r.BlogTOC[blog.Id] = toc
+}
+
+// ListBlogs returns every stored blog
+func (r *MemoryRepository) ListBlogs() ([]models.Blog, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.Blogs), nil
+}
+
+// GetBlog returns the blog with the given id
+func (r *MemoryRepository) GetBlog(id string) (models.Blog, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if blog, ok := findBlog(r.Blogs, id); ok {
+		return blog, nil
+	}
+	return models.Blog{}, os.ErrNotExist
+}
+
+// GetBlogHTML returns the stored HTML body of a blog
+func (r *MemoryRepository) GetBlogHTML(id string) (string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	html, ok := r.BlogHTML[id]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return html, nil
+}
+
+// GetBlogTableOfContents returns the stored table of contents of a blog
+func (r *MemoryRepository) GetBlogTableOfContents(id string) (string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	toc, ok := r.BlogTOC[id]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return toc, nil
+}
+
+// GetBlogMarkdown returns the stored Markdown source of a blog
+func (r *MemoryRepository) GetBlogMarkdown(id string) (string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	markdown, ok := r.BlogMarkdown[id]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return markdown, nil
+}
+
+// ListProjects returns every stored project
+func (r *MemoryRepository) ListProjects() ([]models.Project, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.Projects), nil
+}
+
+// GetProject returns the project with the given slug
+func (r *MemoryRepository) GetProject(slug string) (models.Project, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if project, ok := models.FindProject(r.Projects, slug); ok {
+		return project, nil
+	}
+	return models.Project{}, os.ErrNotExist
+}
+
+// GetProjectHTML returns the stored write-up of a project
+func (r *MemoryRepository) GetProjectHTML(slug string) (string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	html, ok := r.ProjectHTML[slug]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return html, nil
+}
+
+// ListWorkExperiences returns every stored work experience
+func (r *MemoryRepository) ListWorkExperiences() ([]models.WorkExperience, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.WorkExperiences), nil
+}
+
+// ListCertifications returns every stored certification
+func (r *MemoryRepository) ListCertifications() ([]models.Certification, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.Certifications), nil
+}
+
+// ListFavorites returns every stored favorite
+func (r *MemoryRepository) ListFavorites() ([]models.Favorite, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.Favorites), nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"aHobeychi/personal-website/internal/util/logger"
+)
+
+// migration is a single versioned change to the SQLite schema
+// Migrations are append-only: never edit one that has been released, add a new version instead
+type migration struct {
+	version    int
+	name       string
+	statements string
+}
+
+// migrations lists every schema change in the order they must be applied
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create content tables",
+		statements: `
+CREATE TABLE IF NOT EXISTS blogs (
+	id             TEXT PRIMARY KEY,
+	position       INTEGER NOT NULL,
+	title          TEXT NOT NULL,
+	description    TEXT NOT NULL DEFAULT '',
+	tags           TEXT NOT NULL DEFAULT '[]',
+	published_date TEXT NOT NULL DEFAULT '',
+	external_link  TEXT NOT NULL DEFAULT '',
+	html           TEXT NOT NULL DEFAULT '',
+	toc            TEXT NOT NULL DEFAULT ''
+);
+CREATE TABLE IF NOT EXISTS projects (
+	position    INTEGER PRIMARY KEY,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	link        TEXT NOT NULL DEFAULT '',
+	tags        TEXT NOT NULL DEFAULT '[]'
+);
+CREATE TABLE IF NOT EXISTS work_experiences (
+	position     INTEGER PRIMARY KEY,
+	job_title    TEXT NOT NULL,
+	company_name TEXT NOT NULL,
+	description  TEXT NOT NULL DEFAULT '',
+	start_date   TEXT NOT NULL DEFAULT '',
+	end_date     TEXT NOT NULL DEFAULT '',
+	tags         TEXT NOT NULL DEFAULT '[]'
+);
+CREATE TABLE IF NOT EXISTS certifications (
+	position      INTEGER PRIMARY KEY,
+	name          TEXT NOT NULL,
+	issuer        TEXT NOT NULL DEFAULT '',
+	date_received TEXT NOT NULL DEFAULT '',
+	url           TEXT NOT NULL DEFAULT ''
+);`,
+	},
+	{
+		version: 2,
+		name:    "index blog tags and publish dates",
+		statements: `
+CREATE TABLE blog_tags (
+	blog_id TEXT NOT NULL REFERENCES blogs(id) ON DELETE CASCADE,
+	tag     TEXT NOT NULL COLLATE NOCASE,
+	PRIMARY KEY (blog_id, tag)
+);
+CREATE INDEX blog_tags_tag ON blog_tags(tag);
+CREATE INDEX blogs_published_date ON blogs(published_date);
+INSERT OR IGNORE INTO blog_tags (blog_id, tag)
+	SELECT blogs.id, json_each.value FROM blogs, json_each(blogs.tags);`,
+	},
+	{
+		version: 3,
+		name:    "add favorites",
+		statements: `
+CREATE TABLE favorites (
+	position   INTEGER PRIMARY KEY,
+	title      TEXT NOT NULL,
+	url        TEXT NOT NULL DEFAULT '',
+	kind       TEXT NOT NULL DEFAULT '',
+	note       TEXT NOT NULL DEFAULT '',
+	added_date TEXT NOT NULL DEFAULT ''
+);`,
+	},
+	{
+		version: 4,
+		name:    "add blog markdown source",
+		statements: `
+ALTER TABLE blogs ADD COLUMN markdown TEXT NOT NULL DEFAULT '';`,
+	},
+	{
+		version: 5,
+		name:    "add work experience location, employment type and achievements",
+		statements: `
+ALTER TABLE work_experiences ADD COLUMN location TEXT NOT NULL DEFAULT '';
+ALTER TABLE work_experiences ADD COLUMN remote INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE work_experiences ADD COLUMN employment_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE work_experiences ADD COLUMN achievements TEXT NOT NULL DEFAULT '[]';`,
+	},
+	{
+		version: 6,
+		name:    "add certification expiry, credential id and logo",
+		statements: `
+ALTER TABLE certifications ADD COLUMN expiry_date TEXT NOT NULL DEFAULT '';
+ALTER TABLE certifications ADD COLUMN credential_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE certifications ADD COLUMN logo TEXT NOT NULL DEFAULT '';`,
+	},
+	{
+		version: 7,
+		name:    "add project details and write-ups",
+		statements: `
+ALTER TABLE projects ADD COLUMN slug TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN status TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN role TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN start_date TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN end_date TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN screenshots TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE projects ADD COLUMN html TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_projects_slug ON projects (slug COLLATE NOCASE);`,
+	},
+	{
+		version: 8,
+		name:    "add blog updated date",
+		statements: `
+ALTER TABLE blogs ADD COLUMN updated_date TEXT NOT NULL DEFAULT '';`,
+	},
+}
+
+// migrate applies every migration newer than the version recorded in schema_migrations
+// Each migration runs in its own transaction so a failure leaves the database at the previous version
+func migrate(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TEXT NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	current, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		if err := applyMigration(db, m); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", m.version, m.name, err)
+		}
+		logger.LogDebug(fmt.Sprintf("Applied sqlite migration %d: %s", m.version, m.name))
+	}
+
+	return nil
+}
+
+// applyMigration runs a single migration and records it
+func applyMigration(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.statements); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+		m.version, m.name, time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SchemaVersion returns the latest migration version applied to the database
+func SchemaVersion(db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	err := db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
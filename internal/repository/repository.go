@@ -0,0 +1,55 @@
+// Package repository abstracts where the site content is stored
+package repository
+
+import (
+	"fmt"
+
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// Supported content backends, selected through Config.Content.Backend
+const (
+	BackendJSON   = "json"
+	BackendSQLite = "sqlite"
+)
+
+// ContentRepository provides read access to every piece of content rendered by the site.
+// Lookups of a single item return os.ErrNotExist when the item cannot be found.
+type ContentRepository interface {
+	ListBlogs() ([]models.Blog, error)
+	GetBlog(id string) (models.Blog, error)
+	GetBlogHTML(id string) (string, error)
+	GetBlogTableOfContents(id string) (string, error)
+	GetBlogMarkdown(id string) (string, error)
+	ListProjects() ([]models.Project, error)
+	GetProject(slug string) (models.Project, error)
+	GetProjectHTML(slug string) (string, error)
+	ListWorkExperiences() ([]models.WorkExperience, error)
+	ListCertifications() ([]models.Certification, error)
+	ListFavorites() ([]models.Favorite, error)
+}
+
+// New creates the content repository selected in the configuration
+// Defaults to the JSON file backend when no backend is configured
+// The in-memory repository has nothing to load its content from and is only built by tests, see NewMemoryRepository
+func New(c *config.Config) (ContentRepository, error) {
+	switch c.Content.Backend {
+	case "", BackendJSON:
+		return NewJSONRepository(c), nil
+	case BackendSQLite:
+		return NewSQLiteRepository(c.Content.SQLitePath)
+	default:
+		return nil, fmt.Errorf("unknown content backend %q", c.Content.Backend)
+	}
+}
+
+// findBlog returns the blog with the given id from a list of blogs
+func findBlog(blogs []models.Blog, id string) (models.Blog, bool) {
+	for _, blog := range blogs {
+		if blog.Id == id {
+			return blog, true
+		}
+	}
+	return models.Blog{}, false
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+func TestNewSelectsBackend(t *testing.T) {
+	tests := []struct {
+		name        string
+		backend     string
+		expectError bool
+	}{
+		{name: "Default backend", backend: "", expectError: false},
+		{name: "JSON backend", backend: BackendJSON, expectError: false},
+		{name: "Memory backend is test only", backend: "memory", expectError: true},
+		{name: "Unknown backend", backend: "mongodb", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &config.Config{}
+			c.Content.Backend = tt.backend
+			c.Features.CacheTTL = 1
+
+			_, err := New(c)
+			if (err != nil) != tt.expectError {
+				t.Errorf("New() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestMemoryRepository(t *testing.T) {
+	repo := NewMemoryRepository()
+	repo.AddBlog(models.Blog{Id: "first", Title: "First"}, "<p>body</p>", "<ul></ul>")
+
+	blog, err := repo.GetBlog("first")
+	if err != nil || blog.Title != "First" {
+		t.Fatalf("GetBlog() = %v, %v, want First", blog, err)
+	}
+
+	html, err := repo.GetBlogHTML("first")
+	if err != nil || html != "<p>body</p>" {
+		t.Errorf("GetBlogHTML() = %q, %v", html, err)
+	}
+
+	if _, err := repo.GetBlog("missing"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("GetBlog(missing) error = %v, want os.ErrNotExist", err)
+	}
+
+	if _, err := repo.GetBlogTableOfContents("missing"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("GetBlogTableOfContents(missing) error = %v, want os.ErrNotExist", err)
+	}
+
+	// Listings are copies, reordering one leaves the stored blogs alone
+	repo.AddBlog(models.Blog{Id: "second", Title: "Second"}, "", "")
+	listed, _ := repo.ListBlogs()
+	listed[0], listed[1] = listed[1], listed[0]
+	if again, _ := repo.ListBlogs(); again[0].Id != "first" {
+		t.Errorf("ListBlogs()[0] = %q after reordering a previous listing, want first", again[0].Id)
+	}
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	models "aHobeychi/personal-website/internal/domain"
+
+	// Pure Go SQLite driver, the binary is built with CGO_ENABLED=0
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteRepository serves content from an embedded SQLite database
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRepository opens (or creates) the database at path and applies any pending migrations
+func NewSQLiteRepository(path string) (*SQLiteRepository, error) {
+	if path == "" {
+		return nil, errors.New("sqlite backend requires content.sqlitePath to be set")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sqlite directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+// Close releases the underlying database handle
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+// ListBlogs returns every blog ordered by catalog position
+func (r *SQLiteRepository) ListBlogs() ([]models.Blog, error) {
+	rows, err := r.db.Query(`SELECT id, title, description, tags, published_date, updated_date, external_link FROM blogs ORDER BY position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blogs: %w", err)
+	}
+	defer rows.Close()
+
+	var blogs []models.Blog
+	for rows.Next() {
+		blog, err := scanBlog(rows)
+		if err != nil {
+			return nil, err
+		}
+		blogs = append(blogs, blog)
+	}
+	return blogs, rows.Err()
+}
+
+// GetBlog returns the blog with the given id
+func (r *SQLiteRepository) GetBlog(id string) (models.Blog, error) {
+	row := r.db.QueryRow(`SELECT id, title, description, tags, published_date, updated_date, external_link FROM blogs WHERE id = ?`, id)
+	blog, err := scanBlog(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Blog{}, os.ErrNotExist
+	}
+	return blog, err
+}
+
+// GetBlogHTML returns the stored HTML body of a blog
+func (r *SQLiteRepository) GetBlogHTML(id string) (string, error) {
+	return r.blogColumn(id, "html")
+}
+
+// GetBlogTableOfContents returns the stored table of contents of a blog
+func (r *SQLiteRepository) GetBlogTableOfContents(id string) (string, error) {
+	return r.blogColumn(id, "toc")
+}
+
+// GetBlogMarkdown returns the stored Markdown source of a blog
+func (r *SQLiteRepository) GetBlogMarkdown(id string) (string, error) {
+	return r.blogColumn(id, "markdown")
+}
+
+// blogColumn reads a single text column of a blog row
+func (r *SQLiteRepository) blogColumn(id string, column string) (string, error) {
+	var value string
+	err := r.db.QueryRow(`SELECT `+column+` FROM blogs WHERE id = ?`, id).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", os.ErrNotExist
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read blog %s: %w", column, err)
+	}
+	return value, nil
+}
+
+// Columns read by scanProject
+const projectColumns = `name, description, link, tags, slug, status, role, start_date, end_date, screenshots`
+
+// ListProjects returns every project ordered by catalog position
+func (r *SQLiteRepository) ListProjects() ([]models.Project, error) {
+	rows, err := r.db.Query(`SELECT ` + projectColumns + ` FROM projects ORDER BY position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projects []models.Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, rows.Err()
+}
+
+// GetProject returns the project with the given slug, ignoring case
+func (r *SQLiteRepository) GetProject(slug string) (models.Project, error) {
+	row := r.db.QueryRow(`SELECT `+projectColumns+` FROM projects WHERE slug = ? COLLATE NOCASE`, slug)
+	project, err := scanProject(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Project{}, os.ErrNotExist
+	}
+	return project, err
+}
+
+// GetProjectHTML returns the stored write-up of a project, os.ErrNotExist when it has none
+func (r *SQLiteRepository) GetProjectHTML(slug string) (string, error) {
+	var html string
+	err := r.db.QueryRow(`SELECT html FROM projects WHERE slug = ? COLLATE NOCASE`, slug).Scan(&html)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && html == "") {
+		return "", os.ErrNotExist
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read project html: %w", err)
+	}
+	return html, nil
+}
+
+// scanProject reads a project row selected with projectColumns
+func scanProject(row rowScanner) (models.Project, error) {
+	var project models.Project
+	var tags, screenshots string
+	err := row.Scan(&project.Name, &project.Description, &project.Link, &tags,
+		&project.Slug, &project.Status, &project.Role, &project.StartDate, &project.EndDate, &screenshots)
+	if err != nil {
+		return models.Project{}, err
+	}
+	if project.Tags, err = decodeTags(tags); err != nil {
+		return models.Project{}, err
+	}
+	if screenshots != "" && screenshots != "[]" {
+		if err := json.Unmarshal([]byte(screenshots), &project.Screenshots); err != nil {
+			return models.Project{}, fmt.Errorf("invalid screenshots column: %w", err)
+		}
+	}
+	return project, nil
+}
+
+// ListWorkExperiences returns every work experience ordered by catalog position
+func (r *SQLiteRepository) ListWorkExperiences() ([]models.WorkExperience, error) {
+	rows, err := r.db.Query(`SELECT job_title, company_name, description, start_date, end_date, tags,
+		location, remote, employment_type, achievements FROM work_experiences ORDER BY position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query work experiences: %w", err)
+	}
+	defer rows.Close()
+
+	var experiences []models.WorkExperience
+	for rows.Next() {
+		var experience models.WorkExperience
+		var tags, achievements string
+		if err := rows.Scan(&experience.JobTitle, &experience.CompanyName, &experience.Description,
+			&experience.StartDate, &experience.EndDate, &tags,
+			&experience.Location, &experience.Remote, &experience.EmploymentType, &achievements); err != nil {
+			return nil, err
+		}
+		if experience.Tags, err = decodeTags(tags); err != nil {
+			return nil, err
+		}
+		if achievements != "" && achievements != "[]" {
+			if err := json.Unmarshal([]byte(achievements), &experience.Achievements); err != nil {
+				return nil, fmt.Errorf("invalid achievements column: %w", err)
+			}
+		}
+		experiences = append(experiences, experience)
+	}
+	return experiences, rows.Err()
+}
+
+// ListCertifications returns every certification ordered by catalog position
+func (r *SQLiteRepository) ListCertifications() ([]models.Certification, error) {
+	rows, err := r.db.Query(`SELECT name, issuer, date_received, url, expiry_date, credential_id, logo FROM certifications ORDER BY position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifications: %w", err)
+	}
+	defer rows.Close()
+
+	var certifications []models.Certification
+	for rows.Next() {
+		var certification models.Certification
+		if err := rows.Scan(&certification.Name, &certification.Issuer, &certification.DateReceived, &certification.Url,
+			&certification.ExpiryDate, &certification.CredentialID, &certification.Logo); err != nil {
+			return nil, err
+		}
+		certifications = append(certifications, certification)
+	}
+	return certifications, rows.Err()
+}
+
+// ListFavorites returns every favorite ordered by catalog position
+func (r *SQLiteRepository) ListFavorites() ([]models.Favorite, error) {
+	rows, err := r.db.Query(`SELECT title, url, kind, note, added_date FROM favorites ORDER BY position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query favorites: %w", err)
+	}
+	defer rows.Close()
+
+	var favorites []models.Favorite
+	for rows.Next() {
+		var favorite models.Favorite
+		if err := rows.Scan(&favorite.Title, &favorite.Url, &favorite.Kind, &favorite.Note, &favorite.AddedDate); err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, favorite)
+	}
+	return favorites, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanBlog reads a blog from a row selected with the blog metadata columns
+func scanBlog(row rowScanner) (models.Blog, error) {
+	var blog models.Blog
+	var tags string
+	err := row.Scan(&blog.Id, &blog.Title, &blog.Description, &tags, &blog.PublishedDate, &blog.UpdatedDate, &blog.ExternalLink)
+	if err != nil {
+		return models.Blog{}, err
+	}
+	blog.Tags, err = decodeTags(tags)
+	return blog, err
+}
+
+// decodeTags converts a JSON encoded tag column back into a slice
+func decodeTags(raw string) ([]string, error) {
+	var tags []string
+	if raw == "" {
+		return tags, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil, fmt.Errorf("invalid tags column: %w", err)
+	}
+	return tags, nil
+}
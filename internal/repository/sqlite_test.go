@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+func TestSQLiteImportAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.db")
+
+	source := NewMemoryRepository()
+	source.AddBlog(models.Blog{Id: "older", Title: "Older", Tags: []string{"Go"}, PublishedDate: models.MustParseDate("2024-01-10")}, "<p>older</p>", "")
+	source.AddBlog(models.Blog{Id: "newer", Title: "Newer", Tags: []string{"go", "HTMX"}, PublishedDate: models.MustParseDate("2025-03-02")}, "<p>newer</p>", "<ul></ul>")
+	source.AddBlog(models.Blog{Id: "python", Title: "Python", Tags: []string{"Python"}, PublishedDate: models.MustParseDate("2025-05-04")}, "", "")
+	source.Projects = []models.Project{{
+		Name:        "Site",
+		Tags:        []string{"Go"},
+		Status:      models.ProjectArchived,
+		StartDate:   models.MustParseDate("2023-03"),
+		Screenshots: []models.Screenshot{{Src: "images/projects/site.png", Alt: "Home page"}},
+	}}
+	source.ProjectHTML["site"] = "<p>write-up</p>"
+
+	repo, err := NewSQLiteRepository(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	defer repo.Close()
+
+	stats, err := repo.Import(source)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if stats.Blogs != 3 || stats.Projects != 1 {
+		t.Errorf("Import() stats = %+v, want 3 blogs and 1 project", stats)
+	}
+
+	html, err := repo.GetBlogHTML("newer")
+	if err != nil || html != "<p>newer</p>" {
+		t.Errorf("GetBlogHTML() = %q, %v", html, err)
+	}
+
+	project, err := repo.GetProject("SITE")
+	if err != nil || !project.IsArchived() || project.StartDate.String() != "2023-03" || len(project.Screenshots) != 1 {
+		t.Errorf("GetProject() = %+v, %v", project, err)
+	}
+	if html, err := repo.GetProjectHTML("site"); err != nil || html != "<p>write-up</p>" {
+		t.Errorf("GetProjectHTML() = %q, %v", html, err)
+	}
+
+	// Re-importing must replace rather than duplicate rows
+	if _, err := repo.Import(source); err != nil {
+		t.Fatalf("second Import() error = %v", err)
+	}
+	all, err := repo.ListBlogs()
+	if err != nil || len(all) != 3 {
+		t.Errorf("ListBlogs() after re-import = %d blogs, %v", len(all), err)
+	}
+}
+
+func TestSQLiteMigrationsAreIdempotent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "content.db")
+
+	for i := 0; i < 2; i++ {
+		repo, err := NewSQLiteRepository(path)
+		if err != nil {
+			t.Fatalf("NewSQLiteRepository() run %d error = %v", i, err)
+		}
+
+		version, err := SchemaVersion(repo.db)
+		if err != nil {
+			t.Fatalf("SchemaVersion() error = %v", err)
+		}
+		if want := migrations[len(migrations)-1].version; version != want {
+			t.Errorf("SchemaVersion() = %d, want %d", version, want)
+		}
+		repo.Close()
+	}
+}
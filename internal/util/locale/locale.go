@@ -0,0 +1,103 @@
+// Package locale formats dates and durations for display in the configured language
+package locale
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultLocale is used when the configured locale is empty or unsupported
+const DefaultLocale = "en"
+
+// dictionary holds the words needed to format dates in one language
+type dictionary struct {
+	months  [12]string
+	present string
+	year    [2]string // singular, plural
+	month   [2]string // singular, plural
+	// dayFirst formats days as "4 May 2025" instead of "May 4, 2025"
+	dayFirst bool
+}
+
+var dictionaries = map[string]dictionary{
+	"en": {
+		months:  [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		present: "Present",
+		year:    [2]string{"yr", "yrs"},
+		month:   [2]string{"mo", "mos"},
+	},
+	"fr": {
+		months:   [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		present:  "Présent",
+		year:     [2]string{"an", "ans"},
+		month:    [2]string{"mois", "mois"},
+		dayFirst: true,
+	},
+}
+
+// Supported reports whether a locale has a dictionary
+func Supported(locale string) bool {
+	_, ok := dictionaries[normalize(locale)]
+	return ok
+}
+
+// lookup returns the dictionary for a locale, falling back to DefaultLocale
+func lookup(locale string) dictionary {
+	if d, ok := dictionaries[normalize(locale)]; ok {
+		return d
+	}
+	return dictionaries[DefaultLocale]
+}
+
+// normalize reduces a locale such as "fr-CA" to its language
+func normalize(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
+
+// FormatDay formats a full date, e.g. "May 4, 2025" or "4 mai 2025"
+func FormatDay(t time.Time, locale string) string {
+	d := lookup(locale)
+	month := d.months[t.Month()-1]
+	if d.dayFirst {
+		return fmt.Sprintf("%d %s %d", t.Day(), month, t.Year())
+	}
+	return fmt.Sprintf("%s %d, %d", month, t.Day(), t.Year())
+}
+
+// FormatMonth formats a month and year, e.g. "August 2025" or "août 2025"
+func FormatMonth(t time.Time, locale string) string {
+	return fmt.Sprintf("%s %d", lookup(locale).months[t.Month()-1], t.Year())
+}
+
+// Present returns the word used for an open-ended end date
+func Present(locale string) string {
+	return lookup(locale).present
+}
+
+// FormatDuration formats years and months, e.g. "1 yr 2 mos" or "1 an 2 mois"
+// Zero components are omitted, a zero duration is shown as months
+func FormatDuration(years int, months int, locale string) string {
+	d := lookup(locale)
+
+	var parts []string
+	if years > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", years, plural(d.year, years)))
+	}
+	if months > 0 || years == 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", months, plural(d.month, months)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// plural picks the singular or plural form for n
+func plural(forms [2]string, n int) string {
+	if n == 1 {
+		return forms[0]
+	}
+	return forms[1]
+}
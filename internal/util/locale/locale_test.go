@@ -0,0 +1,98 @@
+package locale
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDay(t *testing.T) {
+	day := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "August 4, 2025"},
+		{"fr", "4 août 2025"},
+		{"fr-CA", "4 août 2025"},
+		{"", "August 4, 2025"},
+		{"de", "August 4, 2025"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := FormatDay(day, tt.locale); got != tt.want {
+				t.Errorf("FormatDay(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMonth(t *testing.T) {
+	month := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "February 2025"},
+		{"fr", "février 2025"},
+		{"EN_us", "February 2025"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := FormatMonth(month, tt.locale); got != tt.want {
+				t.Errorf("FormatMonth(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresent(t *testing.T) {
+	if got := Present("en"); got != "Present" {
+		t.Errorf("Present(en) = %q, want Present", got)
+	}
+	if got := Present("fr"); got != "Présent" {
+		t.Errorf("Present(fr) = %q, want Présent", got)
+	}
+	if got := Present("xx"); got != "Present" {
+		t.Errorf("Present(xx) = %q, want the default locale", got)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		years  int
+		months int
+		locale string
+		want   string
+	}{
+		{"zero", 0, 0, "en", "0 mos"},
+		{"one month", 0, 1, "en", "1 mo"},
+		{"months only", 0, 7, "en", "7 mos"},
+		{"one year", 1, 0, "en", "1 yr"},
+		{"years and one month", 2, 1, "en", "2 yrs 1 mo"},
+		{"zero in french", 0, 0, "fr", "0 mois"},
+		{"one year in french", 1, 2, "fr", "1 an 2 mois"},
+		{"years in french", 3, 0, "fr", "3 ans"},
+		{"unsupported locale", 1, 1, "de", "1 yr 1 mo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDuration(tt.years, tt.months, tt.locale); got != tt.want {
+				t.Errorf("FormatDuration(%d, %d, %q) = %q, want %q", tt.years, tt.months, tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupported(t *testing.T) {
+	for locale, want := range map[string]bool{"en": true, "fr-CA": true, "de": false, "": false} {
+		if got := Supported(locale); got != want {
+			t.Errorf("Supported(%q) = %v, want %v", locale, got, want)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Package pdf writes simple text documents as PDF using the standard Helvetica fonts
+// The fonts are built into every PDF reader so nothing has to be embedded
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page size (US Letter) and margins, in points
+const (
+	PageWidth  = 612.0
+	PageHeight = 792.0
+	Margin     = 54.0
+)
+
+// Style describes how a block of text is drawn
+type Style struct {
+	Size        float64 // font size in points
+	Bold        bool
+	Gray        float64 // 0 is black, 1 is white
+	SpaceBefore float64 // extra space above the block, in points
+}
+
+// Document is a PDF document laid out from top to bottom, adding pages as needed
+type Document struct {
+	title string
+	pages []*bytes.Buffer
+	y     float64 // baseline of the last line drawn on the current page
+}
+
+// New creates an empty document, the title is shown by PDF readers
+func New(title string) *Document {
+	d := &Document{title: title}
+	d.newPage()
+	return d
+}
+
+// newPage starts a new page, the next line is drawn below the top margin
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = PageHeight - Margin
+}
+
+// page returns the content stream of the current page
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// nextLine moves down by one line of the given style, starting a new page when it does not fit
+func (d *Document) nextLine(style Style) {
+	leading := style.Size * 1.35
+	if d.y-leading < Margin {
+		d.newPage()
+	}
+	d.y -= leading
+}
+
+// Paragraph draws text wrapped to the width of the page
+func (d *Document) Paragraph(text string, style Style) {
+	d.y -= style.SpaceBefore
+	for _, line := range wrap(text, style, PageWidth-2*Margin) {
+		d.nextLine(style)
+		d.text(Margin, line, style)
+	}
+}
+
+// Row draws left aligned text with right aligned text on its first line, such as a title and its dates
+func (d *Document) Row(left string, right string, style Style) {
+	d.y -= style.SpaceBefore
+	rightWidth := measure(right, style)
+	lines := wrap(left, style, PageWidth-2*Margin-rightWidth-style.Size)
+	for i, line := range lines {
+		d.nextLine(style)
+		d.text(Margin, line, style)
+		if i == 0 && right != "" {
+			d.text(PageWidth-Margin-rightWidth, right, Style{Size: style.Size, Gray: style.Gray})
+		}
+	}
+}
+
+// Rule draws a horizontal line across the page
+func (d *Document) Rule() {
+	if d.y-6 < Margin {
+		d.newPage()
+		return
+	}
+	d.y -= 6
+	fmt.Fprintf(d.page(), "0.75 G 0.5 w %.2f %.2f m %.2f %.2f l S\n", Margin, d.y, PageWidth-Margin, d.y)
+}
+
+// text draws a single line with its baseline at the current position
+func (d *Document) text(x float64, text string, style Style) {
+	font := "F1"
+	if style.Bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT %.2f g /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", style.Gray, font, style.Size, x, d.y, escape(encode(text)))
+}
+
+// Bytes returns the encoded document
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 to 4 are the catalog, the page tree and the fonts, pages and their contents follow in pairs
+	pageIds := make([]string, len(d.pages))
+	for i := range d.pages {
+		pageIds[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageIds, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+	object(fmt.Sprintf("<< /Title (%s) /Producer (personal-website) >>", escape(encode(d.title))))
+	info := len(offsets)
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, info, xref)
+
+	return out.Bytes()
+}
+
+// wrap splits text into lines no wider than width, a single word longer than the line is left whole
+func wrap(text string, style Style, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		var current string
+		for _, word := range strings.Fields(paragraph) {
+			candidate := strings.TrimSpace(current + " " + word)
+			if current != "" && measure(candidate, style) > width {
+				lines = append(lines, current)
+				candidate = word
+			}
+			current = candidate
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// measure returns the width of text in points
+func measure(text string, style Style) float64 {
+	widths := &helveticaWidths
+	if style.Bold {
+		widths = &helveticaBoldWidths
+	}
+
+	var total int
+	for _, c := range []byte(encode(text)) {
+		if c >= 32 && c <= 126 {
+			total += widths[c-32]
+		} else {
+			total += defaultWidth
+		}
+	}
+	return float64(total) * style.Size / 1000
+}
+
+// escape protects the characters with a meaning inside a PDF string
+func escape(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(text)
+}
+
+// encode converts text to WinAnsiEncoding, characters the fonts cannot show become "?"
+func encode(text string) string {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r < 0x80 || (r >= 0xa0 && r <= 0xff):
+			encoded = append(encoded, byte(r))
+		case winAnsi[r] != 0:
+			encoded = append(encoded, winAnsi[r])
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+	return string(encoded)
+}
+
+// winAnsi maps the characters of WinAnsiEncoding outside of Latin-1
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b,
+	'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// Width used for the characters missing from the tables below, in thousandths of the font size
+const defaultWidth = 556
+
+// Widths of the printable ASCII characters, from the Adobe font metrics of Helvetica and Helvetica-Bold
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
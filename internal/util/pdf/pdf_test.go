@@ -0,0 +1,55 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDocumentBytes(t *testing.T) {
+	doc := New("Résumé (draft)")
+	for i := 0; i < 80; i++ {
+		doc.Paragraph(fmt.Sprintf("Line %d – with (parentheses) and a backslash \\", i), Style{Size: 12})
+	}
+	out := doc.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("document is missing the PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Errorf("80 lines should span two pages")
+	}
+	if !bytes.Contains(out, []byte(`(Line 0 `+"\x96"+` with \(parentheses\) and a backslash \\) Tj`)) {
+		t.Errorf("text was not encoded and escaped")
+	}
+
+	// Every cross-reference entry must point at its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(out)
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(out[xref:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		if !strings.HasPrefix(string(out[offset:]), fmt.Sprintf("%d 0 obj", i+1)) {
+			t.Errorf("xref entry %d points at %q", i+1, out[offset:offset+10])
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	style := Style{Size: 10}
+	lines := wrap("The quick brown fox jumps over the lazy dog", style, 100)
+	if len(lines) < 2 {
+		t.Fatalf("wrap() = %q, want several lines", lines)
+	}
+	for _, line := range lines {
+		if measure(line, style) > 100 {
+			t.Errorf("line %q is wider than the page", line)
+		}
+	}
+	if strings.Join(lines, " ") != "The quick brown fox jumps over the lazy dog" {
+		t.Errorf("wrap() lost words: %q", lines)
+	}
+}
@@ -0,0 +1,435 @@
+// Package validator checks the content catalogs for inconsistencies before they are served
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"aHobeychi/personal-website/internal/config"
+	models "aHobeychi/personal-website/internal/domain"
+)
+
+// Severity indicates whether an issue should block the site from being served
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue describes a single inconsistency found in a catalog entry
+// Index is the position of the entry in its catalog, or -1 when the issue concerns the whole file
+type Issue struct {
+	Severity Severity
+	File     string
+	Index    int
+	Field    string
+	Message  string
+}
+
+// String formats the issue as "severity: file[index].field: message"
+func (i Issue) String() string {
+	location := i.File
+	if i.Index >= 0 {
+		location += fmt.Sprintf("[%d]", i.Index)
+	}
+	if i.Field != "" {
+		location += "." + i.Field
+	}
+	return fmt.Sprintf("%s: %s: %s", i.Severity, location, i.Message)
+}
+
+// Report collects every issue found during a validation run
+type Report struct {
+	Issues []Issue
+}
+
+// HasErrors reports whether any issue has error severity
+func (r *Report) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// add records a new issue in the report
+func (r *Report) add(severity Severity, file string, index int, field string, format string, args ...any) {
+	r.Issues = append(r.Issues, Issue{
+		Severity: severity,
+		File:     file,
+		Index:    index,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Validate loads every catalog configured in Paths and returns all inconsistencies found
+func Validate(c *config.Config) Report {
+	var report Report
+
+	validateBlogs(&report, c)
+	validateProjects(&report, c)
+	validateWorkExperiences(&report, c.Paths.WorkExperienceJSON)
+	validateCertifications(&report, c)
+	validateFavorites(&report, c.Paths.FavoritesJSON)
+
+	return report
+}
+
+// entry is a catalog item decoded into its parser model, along with its position in the file
+type entry[T any] struct {
+	index int
+	value T
+}
+
+// loadCatalog decodes a JSON catalog entry by entry into the parser model type
+// Date fields are checked individually first so a bad date is reported against its field
+// and the rest of the entry is still validated; entries that cannot be decoded are skipped
+func loadCatalog[T any](report *Report, path string, dateFields ...string) ([]entry[T], bool) {
+	items, ok := readCatalog(report, path)
+	if !ok {
+		return nil, false
+	}
+
+	var entries []entry[T]
+	for i, raw := range items {
+		if value, ok := decodeEntry[T](report, path, i, "", raw, dateFields); ok {
+			entries = append(entries, entry[T]{index: i, value: value})
+		}
+	}
+	return entries, true
+}
+
+// readCatalog reads the entries of a JSON catalog without decoding them
+func readCatalog(report *Report, path string) ([]json.RawMessage, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		report.add(SeverityError, path, -1, "", "cannot read catalog: %v", err)
+		return nil, false
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		report.add(SeverityError, path, -1, "", "invalid JSON: %v", err)
+		return nil, false
+	}
+	return items, true
+}
+
+// decodeEntry decodes a single catalog entry, reporting its invalid dates first
+// prefix locates an object nested in the entry, e.g. "roles[0]."
+func decodeEntry[T any](report *Report, path string, index int, prefix string, raw json.RawMessage, dateFields []string) (T, bool) {
+	var value T
+	raw, ok := checkDates(report, path, index, prefix, raw, dateFields)
+	if !ok {
+		return value, false
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		report.add(SeverityError, path, index, strings.TrimSuffix(prefix, "."), "invalid entry: %v", err)
+		return value, false
+	}
+	return value, true
+}
+
+// checkDates reports every date field of a raw entry that cannot be parsed
+// and returns the entry with those fields removed so it can still be decoded
+func checkDates(report *Report, path string, index int, prefix string, raw json.RawMessage, fields []string) (json.RawMessage, bool) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		report.add(SeverityError, path, index, strings.TrimSuffix(prefix, "."), "entry must be a JSON object: %v", err)
+		return nil, false
+	}
+
+	invalid := false
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok {
+			continue
+		}
+		var date models.Date
+		if err := date.UnmarshalJSON(value); err != nil {
+			report.add(SeverityError, path, index, prefix+field, "%v", err)
+			delete(values, field)
+			invalid = true
+		}
+	}
+
+	if !invalid {
+		return raw, true
+	}
+	cleaned, err := json.Marshal(values)
+	return cleaned, err == nil
+}
+
+// skipOpenDate reports whether a date field was already reported as unparsable,
+// in which case it must not also be reported as missing
+func skipOpenDate(report *Report, path string, index int, field string) bool {
+	for _, issue := range report.Issues {
+		if issue.File == path && issue.Index == index && issue.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func validateBlogs(report *Report, c *config.Config) {
+	path := c.Paths.BlogsJSON
+	blogs, ok := loadCatalog[models.Blog](report, path, "publishedDate", "updatedDate")
+	if !ok {
+		return
+	}
+
+	seen := map[string]int{}
+	for _, e := range blogs {
+		i, blog := e.index, e.value
+
+		if blog.Id == "" {
+			report.add(SeverityError, path, i, "id", "id is required")
+		} else if first, duplicate := seen[blog.Id]; duplicate {
+			report.add(SeverityError, path, i, "id", "duplicate id %q, first used at index %d", blog.Id, first)
+		} else {
+			seen[blog.Id] = i
+		}
+		if models.IsArchiveSegment(blog.Id) {
+			report.add(SeverityError, path, i, "id", "id %q is taken by the archive pages", blog.Id)
+		}
+
+		if blog.Title == "" {
+			report.add(SeverityError, path, i, "title", "title is required")
+		}
+
+		if blog.PublishedDate.IsOpen() && !skipOpenDate(report, path, i, "publishedDate") {
+			report.add(SeverityError, path, i, "publishedDate", "publishedDate is required")
+		}
+		if !blog.UpdatedDate.IsOpen() && blog.UpdatedDate.Before(blog.PublishedDate.Time) {
+			report.add(SeverityError, path, i, "updatedDate", "updated date %s is before published date %s", blog.UpdatedDate, blog.PublishedDate)
+		}
+
+		validateURL(report, path, i, "externalLink", blog.ExternalLink, false)
+
+		if blog.Id == "" {
+			continue
+		}
+
+		htmlPath := filepath.Join(c.Paths.BlogHTML, blog.Id+".html")
+		if !fileExists(htmlPath) {
+			report.add(SeverityError, path, i, "id", "missing blog content %s", htmlPath)
+		}
+
+		tocPath := filepath.Join(c.Paths.TocHTML, blog.Id+"-toc.html")
+		if !fileExists(tocPath) {
+			report.add(SeverityWarning, path, i, "id", "missing table of contents %s", tocPath)
+		}
+	}
+}
+
+func validateProjects(report *Report, c *config.Config) {
+	path := c.Paths.ProjectsJSON
+	projects, ok := loadCatalog[models.Project](report, path, "startDate", "endDate")
+	if !ok {
+		return
+	}
+
+	seen := map[string]int{}
+	slugs := map[string]int{}
+	for _, e := range projects {
+		i, project := e.index, e.value
+
+		if project.Name == "" {
+			report.add(SeverityError, path, i, "name", "name is required")
+		} else if first, duplicate := seen[project.Name]; duplicate {
+			report.add(SeverityError, path, i, "name", "duplicate name %q, first used at index %d", project.Name, first)
+		} else {
+			seen[project.Name] = i
+		}
+
+		// Two projects with the same slug would share the same /project/{slug} page
+		if slug := strings.ToLower(project.ID()); slug != "" {
+			if first, duplicate := slugs[slug]; duplicate {
+				report.add(SeverityError, path, i, "slug", "duplicate slug %q, first used at index %d", project.ID(), first)
+			} else {
+				slugs[slug] = i
+			}
+		}
+
+		validateURL(report, path, i, "link", project.Link, false)
+
+		if project.Status != "" && !slices.Contains(models.ProjectStatuses, project.Status) {
+			report.add(SeverityWarning, path, i, "status", "unknown status %q, expected one of %v", project.Status, models.ProjectStatuses)
+		}
+		if !project.StartDate.IsOpen() && !project.EndDate.IsOpen() && project.EndDate.Before(project.StartDate.Time) {
+			report.add(SeverityError, path, i, "endDate", "end date %s is before start date %s", project.EndDate, project.StartDate)
+		}
+
+		for j, screenshot := range project.Screenshots {
+			field := fmt.Sprintf("screenshots[%d]", j)
+			if screenshot.Src == "" {
+				report.add(SeverityError, path, i, field+".src", "src is required")
+				continue
+			}
+			if screenshot.Alt == "" {
+				report.add(SeverityWarning, path, i, field+".alt", "alt text is missing")
+			}
+			image := filepath.Join(c.Paths.AssetFiles, filepath.FromSlash(strings.TrimPrefix(screenshot.Src, "/")))
+			if _, err := os.Stat(image); err != nil {
+				report.add(SeverityError, path, i, field+".src", "screenshot %s not found under %s", screenshot.Src, c.Paths.AssetFiles)
+			}
+		}
+	}
+}
+
+// validateWorkExperiences checks the work experience catalog, whose entries are either
+// a single role or a company listing its roles under "roles"
+func validateWorkExperiences(report *Report, path string) {
+	items, ok := readCatalog(report, path)
+	if !ok {
+		return
+	}
+
+	for i, raw := range items {
+		var group struct {
+			CompanyName string            `json:"companyName"`
+			Roles       []json.RawMessage `json:"roles"`
+		}
+		if err := json.Unmarshal(raw, &group); err != nil {
+			report.add(SeverityError, path, i, "", "invalid entry: %v", err)
+			continue
+		}
+
+		if group.Roles == nil {
+			validateRole(report, path, i, "", raw, "")
+			continue
+		}
+
+		if group.CompanyName == "" {
+			report.add(SeverityError, path, i, "companyName", "companyName is required")
+		}
+		if len(group.Roles) == 0 {
+			report.add(SeverityError, path, i, "roles", "roles must list at least one role")
+		}
+		for j, role := range group.Roles {
+			validateRole(report, path, i, fmt.Sprintf("roles[%d].", j), role, group.CompanyName)
+		}
+	}
+}
+
+// validateRole checks a single role, prefix locates it inside a company entry that provides its companyName
+func validateRole(report *Report, path string, i int, prefix string, raw json.RawMessage, companyName string) {
+	experience, ok := decodeEntry[models.WorkExperience](report, path, i, prefix, raw, []string{"startDate", "endDate"})
+	if !ok {
+		return
+	}
+
+	if experience.JobTitle == "" {
+		report.add(SeverityError, path, i, prefix+"jobTitle", "jobTitle is required")
+	}
+	if experience.CompanyName == "" && companyName == "" {
+		report.add(SeverityError, path, i, prefix+"companyName", "companyName is required")
+	}
+
+	switch {
+	case experience.StartDate.IsOpen():
+		if !skipOpenDate(report, path, i, prefix+"startDate") {
+			report.add(SeverityError, path, i, prefix+"startDate", "startDate is required")
+		}
+	case experience.IsCurrent():
+		// An ongoing position, or an end date already reported as unparsable
+	case experience.EndDate.Before(experience.StartDate.Time):
+		report.add(SeverityError, path, i, prefix+"endDate", "end date %s is before start date %s", experience.EndDate, experience.StartDate)
+	}
+
+	if experience.EmploymentType != "" && !slices.Contains(models.EmploymentTypes, experience.EmploymentType) {
+		report.add(SeverityWarning, path, i, prefix+"employmentType", "unknown employment type %q, expected one of %v", experience.EmploymentType, models.EmploymentTypes)
+	}
+	for k, achievement := range experience.Achievements {
+		if achievement.Text == "" {
+			report.add(SeverityError, path, i, fmt.Sprintf("%sachievements[%d].text", prefix, k), "achievement text is required")
+		}
+	}
+}
+
+func validateCertifications(report *Report, c *config.Config) {
+	path := c.Paths.CertificationsJSON
+	certifications, ok := loadCatalog[models.Certification](report, path, "dateReceived", "expiryDate")
+	if !ok {
+		return
+	}
+
+	for _, e := range certifications {
+		i, certification := e.index, e.value
+
+		if certification.Name == "" {
+			report.add(SeverityError, path, i, "name", "name is required")
+		}
+		if certification.DateReceived.IsOpen() && !skipOpenDate(report, path, i, "dateReceived") {
+			report.add(SeverityError, path, i, "dateReceived", "dateReceived is required")
+		}
+		validateURL(report, path, i, "url", certification.Url, true)
+
+		if certification.Expires() {
+			switch {
+			case !certification.DateReceived.IsOpen() && certification.ExpiryDate.Before(certification.DateReceived.Time):
+				report.add(SeverityError, path, i, "expiryDate", "expiry date %s is before the date received %s", certification.ExpiryDate, certification.DateReceived)
+			case certification.IsExpired(time.Now()):
+				report.add(SeverityWarning, path, i, "expiryDate", "expired on %s, left out of the resume exports", certification.ExpiryDate)
+			}
+		}
+
+		if certification.Logo != "" {
+			logo := filepath.Join(c.Paths.AssetFiles, filepath.FromSlash(strings.TrimPrefix(certification.Logo, "/")))
+			if _, err := os.Stat(logo); err != nil {
+				report.add(SeverityError, path, i, "logo", "logo %s not found under %s", certification.Logo, c.Paths.AssetFiles)
+			}
+		}
+	}
+}
+
+func validateFavorites(report *Report, path string) {
+	favorites, ok := loadCatalog[models.Favorite](report, path, "addedDate")
+	if !ok {
+		return
+	}
+
+	for _, e := range favorites {
+		i, favorite := e.index, e.value
+
+		if favorite.Title == "" {
+			report.add(SeverityError, path, i, "title", "title is required")
+		}
+		validateURL(report, path, i, "url", favorite.Url, false)
+
+		if favorite.Kind != "" && !slices.Contains(models.FavoriteKinds, favorite.Kind) {
+			report.add(SeverityWarning, path, i, "kind", "unknown kind %q, expected one of %v", favorite.Kind, models.FavoriteKinds)
+		}
+	}
+}
+
+// validateURL checks that a link is an absolute http(s) URL
+func validateURL(report *Report, path string, index int, field string, raw string, required bool) {
+	if raw == "" {
+		if required {
+			report.add(SeverityError, path, index, field, "%s is required", field)
+		}
+		return
+	}
+
+	parsed, err := url.ParseRequestURI(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		report.add(SeverityError, path, index, field, "%q is not a well formed http(s) URL", raw)
+	}
+}
+
+// fileExists reports whether path exists and is a regular file
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"aHobeychi/personal-website/internal/config"
+)
+
+// writeFile creates a file under dir with the given content
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	c := &config.Config{}
+	c.Paths.BlogHTML = filepath.Join(dir, "html")
+	c.Paths.TocHTML = filepath.Join(dir, "toc")
+	c.Paths.BlogsJSON = writeFile(t, dir, "blogs.json", `[
+		{"id": "good", "title": "Good", "publishedDate": "2025-04-20"},
+		{"id": "good", "title": "Duplicate", "publishedDate": "20-04-2025"},
+		{"id": "2024", "title": "Year", "publishedDate": "2024-01-01", "updatedDate": "2023-12-31"}
+	]`)
+	c.Paths.ProjectsJSON = writeFile(t, dir, "projects.json", `[
+		{"name": "Site", "link": "not a url"},
+		{"name": "Other", "slug": "site", "status": "paused", "startDate": "2024-06", "endDate": "2023-01",
+			"screenshots": [{"src": "images/projects/missing.png"}]}
+	]`)
+	c.Paths.WorkExperienceJSON = writeFile(t, dir, "work-experience.json", `[
+		{"jobTitle": "Dev", "companyName": "Co", "startDate": "March 2025", "endDate": "Current"},
+		{"jobTitle": "Dev", "companyName": "Co", "startDate": "March 2025", "endDate": "January 2024"},
+		{"companyName": "Group", "roles": [
+			{"jobTitle": "Lead", "startDate": "June 2022", "endDate": "January 2023"},
+			{"jobTitle": "Dev", "startDate": "January 2021", "endDate": "June 2022", "employmentType": "gig", "achievements": [{"metric": "10%"}]}
+		]},
+		{"companyName": "Empty", "roles": []}
+	]`)
+	c.Paths.AssetFiles = filepath.Join(dir, "assets")
+	c.Paths.CertificationsJSON = writeFile(t, dir, "certifications.json", `[
+		{"name": "Cert", "dateReceived": "2025-02-03", "url": "https://example.com/badge"},
+		{"name": "Old", "dateReceived": "2020-01-10", "expiryDate": "2023-01-10", "url": "https://example.com/old", "logo": "images/issuers/old.svg"},
+		{"name": "Backwards", "dateReceived": "2024-05-01", "expiryDate": "2024-01", "url": "https://example.com/backwards"}
+	]`)
+	c.Paths.FavoritesJSON = writeFile(t, dir, "favorites.json", `[{"title": "Go", "url": ""}]`)
+	writeFile(t, dir, "html/good.html", "<p>content</p>")
+
+	report := Validate(c)
+
+	expected := []string{
+		"blogs.json[0].id: missing table of contents",
+		"blogs.json[1].id: duplicate id \"good\", first used at index 0",
+		"blogs.json[1].publishedDate: cannot parse date \"20-04-2025\"",
+		"blogs.json[2].id: id \"2024\" is taken by the archive pages",
+		"blogs.json[2].updatedDate: updated date 2023-12-31 is before published date 2024-01-01",
+		"projects.json[0].link: \"not a url\" is not a well formed http(s) URL",
+		"projects.json[1].slug: duplicate slug \"site\", first used at index 0",
+		"projects.json[1].status: unknown status \"paused\"",
+		"projects.json[1].endDate: end date 2023-01 is before start date 2024-06",
+		"projects.json[1].screenshots[0].alt: alt text is missing",
+		"projects.json[1].screenshots[0].src: screenshot images/projects/missing.png not found",
+		"work-experience.json[1].endDate: end date 2024-01 is before start date 2025-03",
+		"certifications.json[1].expiryDate: expired on 2023-01-10",
+		"certifications.json[1].logo: logo images/issuers/old.svg not found",
+		"certifications.json[2].expiryDate: expiry date 2024-01 is before the date received 2024-05-01",
+		"work-experience.json[2].roles[1].employmentType: unknown employment type \"gig\"",
+		"work-experience.json[2].roles[1].achievements[0].text: achievement text is required",
+		"work-experience.json[3].roles: roles must list at least one role",
+	}
+
+	var messages []string
+	for _, issue := range report.Issues {
+		messages = append(messages, issue.String())
+	}
+	joined := strings.Join(messages, "\n")
+
+	for _, want := range expected {
+		if !strings.Contains(joined, want) {
+			t.Errorf("Validate() issues missing %q, got:\n%s", want, joined)
+		}
+	}
+
+	if !report.HasErrors() {
+		t.Error("HasErrors() = false, want true")
+	}
+}
+
+func TestValidateMissingCatalog(t *testing.T) {
+	c := &config.Config{}
+	c.Paths.BlogsJSON = filepath.Join(t.TempDir(), "missing.json")
+
+	report := Validate(c)
+	if !report.HasErrors() {
+		t.Fatal("HasErrors() = false, want true for a missing catalog")
+	}
+	if report.Issues[0].Index != -1 {
+		t.Errorf("Issues[0].Index = %d, want -1 for file level issue", report.Issues[0].Index)
+	}
+}